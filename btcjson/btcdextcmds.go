@@ -8,6 +8,8 @@
 
 package btcjson
 
+import "fmt"
+
 // NodeSubCmd defines the type used in the addnode JSON-RPC command for the
 // sub command field.
 type NodeSubCmd string
@@ -44,6 +46,36 @@ func NewNodeCmd(subCmd NodeSubCmd, target string, connectSubCmd *string) *NodeCm
 	}
 }
 
+// validateParams ensures the sub command is known and that the connection
+// type, which is only valid for the connect sub command, is perm or temp.
+func (c *NodeCmd) validateParams() error {
+	switch c.SubCmd {
+	case NConnect:
+		if c.ConnectSubCmd == nil {
+			return nil
+		}
+		switch *c.ConnectSubCmd {
+		case "perm", "temp":
+			return nil
+		}
+		str := fmt.Sprintf("parameter 'connectsubcmd' must be perm "+
+			"or temp (got %q)", *c.ConnectSubCmd)
+		return makeError(ErrInvalidParameter, str)
+
+	case NRemove, NDisconnect:
+		if c.ConnectSubCmd != nil {
+			str := fmt.Sprintf("parameter 'connectsubcmd' is only "+
+				"valid with the %s sub command", NConnect)
+			return makeError(ErrInvalidParameter, str)
+		}
+		return nil
+	}
+
+	str := fmt.Sprintf("parameter 'subcmd' must be one of %s, %s or %s "+
+		"(got %q)", NConnect, NRemove, NDisconnect, c.SubCmd)
+	return makeError(ErrInvalidParameter, str)
+}
+
 // DebugLevelCmd defines the debuglevel JSON-RPC command.  This command is not a
 // standard Bitcoin command.  It is an extension for btcd.
 type DebugLevelCmd struct {
@@ -111,6 +143,19 @@ func NewGetHeadersCmd(blockLocators []string, hashStop string) *GetHeadersCmd {
 	}
 }
 
+// validateParams ensures each of the block locators and the stop hash are valid
+// hashes.  An empty or all-zero stop hash requests as many headers as allowed.
+func (c *GetHeadersCmd) validateParams() error {
+	for i, locator := range c.BlockLocators {
+		err := checkHashParam(fmt.Sprintf("blocklocators[%d]", i),
+			locator)
+		if err != nil {
+			return err
+		}
+	}
+	return checkHashParam("hashstop", c.HashStop)
+}
+
 // VersionCmd defines the version JSON-RPC command.
 //
 // NOTE: This is a btcsuite extension ported from
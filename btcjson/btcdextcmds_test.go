@@ -101,6 +101,20 @@ func TestBtcdExtCmds(t *testing.T) {
 				ConnectSubCmd: btcjson.String("temp"),
 			},
 		},
+		{
+			name: "node connect without connection type",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("node", btcjson.NConnect, "1.1.1.1")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewNodeCmd("connect", "1.1.1.1", nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"node","params":["connect","1.1.1.1"],"id":1}`,
+			unmarshalled: &btcjson.NodeCmd{
+				SubCmd: btcjson.NConnect,
+				Target: "1.1.1.1",
+			},
+		},
 		{
 			name: "generate",
 			newCmd: func() (interface{}, error) {
@@ -176,6 +190,27 @@ func TestBtcdExtCmds(t *testing.T) {
 				HashStop: "000000000000000000ba33b33e1fad70b69e234fc24414dd47113bff38f523f7",
 			},
 		},
+		{
+			name: "getheaders - all-zero stop hash",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("getheaders", []string{"000000000000000001f1739002418e2f9a84c47a4fd2a0eb7a787a6b7dc12f16"}, "0000000000000000000000000000000000000000000000000000000000000000")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewGetHeadersCmd(
+					[]string{
+						"000000000000000001f1739002418e2f9a84c47a4fd2a0eb7a787a6b7dc12f16",
+					},
+					"0000000000000000000000000000000000000000000000000000000000000000",
+				)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getheaders","params":[["000000000000000001f1739002418e2f9a84c47a4fd2a0eb7a787a6b7dc12f16"],"0000000000000000000000000000000000000000000000000000000000000000"],"id":1}`,
+			unmarshalled: &btcjson.GetHeadersCmd{
+				BlockLocators: []string{
+					"000000000000000001f1739002418e2f9a84c47a4fd2a0eb7a787a6b7dc12f16",
+				},
+				HashStop: "0000000000000000000000000000000000000000000000000000000000000000",
+			},
+		},
 		{
 			name: "version",
 			newCmd: func() (interface{}, error) {
@@ -207,6 +242,13 @@ func TestBtcdExtCmds(t *testing.T) {
 			continue
 		}
 
+		// Ensure the params reported for the command match the
+		// marshalled params.
+		if err := checkCmdParams(test.staticCmd(), marshalled); err != nil {
+			t.Errorf("Test #%d (%s) %v", i, test.name, err)
+			continue
+		}
+
 		// Ensure the command is created without error via the generic
 		// new command creation function.
 		cmd, err := test.newCmd()
@@ -255,3 +297,73 @@ func TestBtcdExtCmds(t *testing.T) {
 		}
 	}
 }
+
+// TestBtcdExtCmdParamErrors ensures UnmarshalCmd rejects btcd extension
+// commands whose parameters do not satisfy the requirements of the command.
+func TestBtcdExtCmdParamErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []unmarshalCmdErrorTest{
+		{
+			name: "unknown sub command for node",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "node",
+				Params:  []json.RawMessage{[]byte(`"drop"`), []byte(`"1.1.1.1"`)},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name: "unknown connection type for node",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "node",
+				Params:  []json.RawMessage{[]byte(`"connect"`), []byte(`"1.1.1.1"`), []byte(`"forever"`)},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name: "connection type with node remove",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "node",
+				Params:  []json.RawMessage{[]byte(`"remove"`), []byte(`"1.1.1.1"`), []byte(`"perm"`)},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name: "connection type with node disconnect",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "node",
+				Params:  []json.RawMessage{[]byte(`"disconnect"`), []byte(`"1.1.1.1"`), []byte(`"temp"`)},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name: "malformed block locator for getheaders",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "getheaders",
+				Params:  []json.RawMessage{[]byte(`["123","xyz"]`), []byte(`""`)},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name: "malformed stop hash for getheaders",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "getheaders",
+				Params:  []json.RawMessage{[]byte(`["123"]`), []byte(`"xyz"`)},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+	}
+	testUnmarshalCmdErrors(t, tests)
+}
@@ -0,0 +1,21 @@
+// Copyright (c) 2020 The btcsuite developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+/*
+Package btcjsontest provides helpers for testing JSON-RPC commands built on the
+btcjson package.
+
+It is primarily intended for projects which register their own custom commands
+with btcjson.RegisterCmd and want to verify those commands survive a round
+trip through the generic marshalling and parsing code.  For example:
+
+	func init() {
+		btcjson.MustRegisterCmd("mycmd", (*MyCmd)(nil), 0)
+	}
+
+	func TestMyCmd(t *testing.T) {
+		btcjsontest.TestRoundTrip(t, &MyCmd{Arg: "value"})
+	}
+*/
+package btcjsontest
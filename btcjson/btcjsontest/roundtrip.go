@@ -0,0 +1,57 @@
+// Copyright (c) 2020 The btcsuite developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package btcjsontest
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcjson"
+)
+
+// TestRoundTrip marshals the passed command, which must be registered with
+// btcjson, parses the resulting request back into a command via
+// btcjson.UnmarshalCmd and reports a test error when the two commands are not
+// equal.
+//
+// Since parsing populates the default value of any omitted optional
+// parameters, optional fields with a default value must be set on the passed
+// command for it to compare equal.
+func TestRoundTrip(t *testing.T, cmd interface{}) {
+	t.Helper()
+
+	method, err := btcjson.CmdMethod(cmd)
+	if err != nil {
+		t.Errorf("%T is not a registered command: %v", cmd, err)
+		return
+	}
+
+	marshalled, err := btcjson.MarshalCmd(1, cmd)
+	if err != nil {
+		t.Errorf("%s: unexpected error marshalling command: %v",
+			method, err)
+		return
+	}
+
+	var request btcjson.Request
+	if err := json.Unmarshal(marshalled, &request); err != nil {
+		t.Errorf("%s: unexpected error unmarshalling request %s: %v",
+			method, marshalled, err)
+		return
+	}
+
+	parsed, err := btcjson.UnmarshalCmd(&request)
+	if err != nil {
+		t.Errorf("%s: unexpected error parsing request %s: %v",
+			method, marshalled, err)
+		return
+	}
+
+	if !reflect.DeepEqual(parsed, cmd) {
+		t.Errorf("%s: mismatched command after round trip - got %+v, "+
+			"want %+v", method, parsed, cmd)
+	}
+}
@@ -0,0 +1,43 @@
+// Copyright (c) 2020 The btcsuite developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package btcjsontest_test
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcjson"
+	"github.com/btcsuite/btcd/btcjson/btcjsontest"
+)
+
+// TestRoundTrip ensures the round trip helper accepts a variety of the
+// commands registered by btcjson, covering required, optional, slice, map and
+// struct parameters.
+func TestRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	cmds := []interface{}{
+		btcjson.NewGetBlockCountCmd(),
+		btcjson.NewGetBlockHashCmd(123),
+		btcjson.NewGetBalanceCmd(btcjson.String("acct"), btcjson.Int(6)),
+		btcjson.NewGetAddressBalanceCmd([]string{
+			"1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2",
+		}),
+		btcjson.NewSendManyCmd("from", map[string]float64{
+			"1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2": 0.5,
+		}, btcjson.Int(6), btcjson.String("comment")),
+		btcjson.NewRescanCmd("123", []string{"1Address"},
+			[]btcjson.OutPoint{{Hash: "123", Index: 0}},
+			btcjson.String("456")),
+		btcjson.NewNodeCmd(btcjson.NConnect, "1.1.1.1",
+			btcjson.String("perm")),
+		btcjson.NewRenameAccountCmd("old", "new"),
+		btcjson.NewWalletPassphraseChangedNtfn("acct", 60),
+	}
+
+	t.Logf("Running %d tests", len(cmds))
+	for _, cmd := range cmds {
+		btcjsontest.TestRoundTrip(t, cmd)
+	}
+}
@@ -20,6 +20,15 @@ func NewCreateNewAccountCmd(account string) *CreateNewAccountCmd {
 	}
 }
 
+// validateParams ensures the new account name is not empty.
+func (c *CreateNewAccountCmd) validateParams() error {
+	if c.Account == "" {
+		return makeError(ErrInvalidParameter, "parameter 'account' "+
+			"must not be empty")
+	}
+	return nil
+}
+
 // DumpWalletCmd defines the dumpwallet JSON-RPC command.
 type DumpWalletCmd struct {
 	Filename string
@@ -48,14 +57,20 @@ func NewImportAddressCmd(address string, rescan *bool) *ImportAddressCmd {
 	}
 }
 
-// ImportPubKeyCmd defines the importpubkey JSON-RPC command.
+// ImportPubKeyCmd defines the importpubkey JSON-RPC command.  Unlike
+// importprivkey, the label follows the rescan flag so requests without a label
+// remain compatible with servers which do not support it.
 type ImportPubKeyCmd struct {
 	PubKey string
-	Rescan *bool `jsonrpcdefault:"true"`
+	Rescan *bool `jsonrpcdefault:"true" jsonrpcnullable:"true"`
+	Label  *string
 }
 
 // NewImportPubKeyCmd returns a new instance which can be used to issue an
 // importpubkey JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
 func NewImportPubKeyCmd(pubKey string, rescan *bool) *ImportPubKeyCmd {
 	return &ImportPubKeyCmd{
 		PubKey: pubKey,
@@ -63,6 +78,19 @@ func NewImportPubKeyCmd(pubKey string, rescan *bool) *ImportPubKeyCmd {
 	}
 }
 
+// WithLabel sets the label to assign the imported public key and returns the
+// command so calls may be chained.
+func (c *ImportPubKeyCmd) WithLabel(label string) *ImportPubKeyCmd {
+	c.Label = &label
+	return c
+}
+
+// validateParams ensures the public key is a hex-encoded compressed (33 byte)
+// or uncompressed (65 byte) public key.
+func (c *ImportPubKeyCmd) validateParams() error {
+	return checkPubKeyParam("pubkey", c.PubKey)
+}
+
 // ImportWalletCmd defines the importwallet JSON-RPC command.
 type ImportWalletCmd struct {
 	Filename string
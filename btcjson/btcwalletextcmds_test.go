@@ -14,6 +14,13 @@ import (
 	"github.com/btcsuite/btcd/btcjson"
 )
 
+// testPubKey and testPubKeyUncompressed are compressed and uncompressed
+// serialized public keys used to test the commands which import public keys.
+const (
+	testPubKey             = "022afc20bf379bc96a2f4e9e63ffceb8652b2b6a097f63fbee6ecec2a49a48010e"
+	testPubKeyUncompressed = "04678afdb0fe5548271967f1a67130b7105cd6a828e03909a67962e0ea1f61deb649f6bc3f4cef38c4f35504e51ec112de5c384df7ba0b8d578a4c702b6bf11d5f"
+)
+
 // TestBtcWalletExtCmds tests all of the btcwallet extended commands marshal and
 // unmarshal into valid results include handling of optional fields being
 // omitted in the marshalled command, while optional fields with defaults have
@@ -86,29 +93,59 @@ func TestBtcWalletExtCmds(t *testing.T) {
 		{
 			name: "importpubkey",
 			newCmd: func() (interface{}, error) {
-				return btcjson.NewCmd("importpubkey", "031234")
+				return btcjson.NewCmd("importpubkey", testPubKey)
 			},
 			staticCmd: func() interface{} {
-				return btcjson.NewImportPubKeyCmd("031234", nil)
+				return btcjson.NewImportPubKeyCmd(testPubKey, nil)
 			},
-			marshalled: `{"jsonrpc":"1.0","method":"importpubkey","params":["031234"],"id":1}`,
+			marshalled: `{"jsonrpc":"1.0","method":"importpubkey","params":["` + testPubKey + `"],"id":1}`,
 			unmarshalled: &btcjson.ImportPubKeyCmd{
-				PubKey: "031234",
+				PubKey: testPubKey,
 				Rescan: btcjson.Bool(true),
 			},
 		},
 		{
 			name: "importpubkey optional",
 			newCmd: func() (interface{}, error) {
-				return btcjson.NewCmd("importpubkey", "031234", false)
+				return btcjson.NewCmd("importpubkey", testPubKey, false)
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewImportPubKeyCmd(testPubKey, btcjson.Bool(false))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"importpubkey","params":["` + testPubKey + `",false],"id":1}`,
+			unmarshalled: &btcjson.ImportPubKeyCmd{
+				PubKey: testPubKey,
+				Rescan: btcjson.Bool(false),
+			},
+		},
+		{
+			name: "importpubkey optional with label",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("importpubkey", testPubKey, false, "label")
 			},
 			staticCmd: func() interface{} {
-				return btcjson.NewImportPubKeyCmd("031234", btcjson.Bool(false))
+				return btcjson.NewImportPubKeyCmd(testPubKey, btcjson.Bool(false)).WithLabel("label")
 			},
-			marshalled: `{"jsonrpc":"1.0","method":"importpubkey","params":["031234",false],"id":1}`,
+			marshalled: `{"jsonrpc":"1.0","method":"importpubkey","params":["` + testPubKey + `",false,"label"],"id":1}`,
 			unmarshalled: &btcjson.ImportPubKeyCmd{
-				PubKey: "031234",
+				PubKey: testPubKey,
 				Rescan: btcjson.Bool(false),
+				Label:  btcjson.String("label"),
+			},
+		},
+		{
+			name: "importpubkey label with default rescan",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("importpubkey", testPubKeyUncompressed, nil, "label")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewImportPubKeyCmd(testPubKeyUncompressed, nil).WithLabel("label")
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"importpubkey","params":["` + testPubKeyUncompressed + `",null,"label"],"id":1}`,
+			unmarshalled: &btcjson.ImportPubKeyCmd{
+				PubKey: testPubKeyUncompressed,
+				Rescan: btcjson.Bool(true),
+				Label:  btcjson.String("label"),
 			},
 		},
 		{
@@ -158,6 +195,13 @@ func TestBtcWalletExtCmds(t *testing.T) {
 			continue
 		}
 
+		// Ensure the params reported for the command match the
+		// marshalled params.
+		if err := checkCmdParams(test.staticCmd(), marshalled); err != nil {
+			t.Errorf("Test #%d (%s) %v", i, test.name, err)
+			continue
+		}
+
 		// Ensure the command is created without error via the generic
 		// new command creation function.
 		cmd, err := test.newCmd()
@@ -206,3 +250,74 @@ func TestBtcWalletExtCmds(t *testing.T) {
 		}
 	}
 }
+
+// TestBtcWalletExtCmdParamErrors ensures UnmarshalCmd rejects btcwallet
+// extension commands whose parameters do not satisfy the requirements of the
+// command.
+func TestBtcWalletExtCmdParamErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []unmarshalCmdErrorTest{
+		{
+			name: "too few params for renameaccount",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "renameaccount",
+				Params:  []json.RawMessage{[]byte(`"oldacct"`)},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrTooFewParams},
+		},
+		{
+			name: "too many params for renameaccount",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "renameaccount",
+				Params:  []json.RawMessage{[]byte(`"oldacct"`), []byte(`"newacct"`), []byte(`"extra"`)},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrTooManyParams},
+		},
+		{
+			name: "empty account for createnewaccount",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "createnewaccount",
+				Params:  []json.RawMessage{[]byte(`""`)},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name: "whitespace account for createnewaccount",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "createnewaccount",
+				Params:  []json.RawMessage{[]byte(`"  "`)},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name: "importpubkey pubkey not hex",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "importpubkey",
+				Params:  []json.RawMessage{[]byte(`"02zz"`)},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name: "importpubkey pubkey wrong length",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "importpubkey",
+				Params:  []json.RawMessage{[]byte(`"031234"`)},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+	}
+	testUnmarshalCmdErrors(t, tests)
+}
@@ -10,6 +10,7 @@ package btcjson
 import (
 	"encoding/json"
 	"fmt"
+	"strconv"
 
 	"github.com/btcsuite/btcd/wire"
 )
@@ -56,7 +57,7 @@ type TransactionInput struct {
 // CreateRawTransactionCmd defines the createrawtransaction JSON-RPC command.
 type CreateRawTransactionCmd struct {
 	Inputs   []TransactionInput
-	Amounts  map[string]float64 `jsonrpcusage:"{\"address\":amount,...}"` // In BTC
+	Amounts  map[string]float64 `jsonrpcusage:"{\"address\":amount,...}" jsonrpcamount:"true"` // In BTC
 	LockTime *int64
 }
 
@@ -127,6 +128,64 @@ func NewGetBestBlockHashCmd() *GetBestBlockHashCmd {
 	return &GetBestBlockHashCmd{}
 }
 
+// BlockID identifies a block by either its hash or its height.  It is
+// marshalled to and from JSON as a string when it holds a hash and as a number
+// when it holds a height.
+type BlockID struct {
+	Hash     string
+	Height   int32
+	IsHeight bool
+}
+
+// NewBlockIDFromHash returns a BlockID which identifies a block by the passed
+// hash.
+func NewBlockIDFromHash(hash string) BlockID {
+	return BlockID{Hash: hash}
+}
+
+// NewBlockIDFromHeight returns a BlockID which identifies a block by the passed
+// height.
+func NewBlockIDFromHeight(height int32) BlockID {
+	return BlockID{Height: height, IsHeight: true}
+}
+
+// String returns the block hash, or the decimal block height when the BlockID
+// identifies a block by its height.
+func (b BlockID) String() string {
+	if b.IsHeight {
+		return strconv.FormatInt(int64(b.Height), 10)
+	}
+	return b.Hash
+}
+
+// MarshalJSON provides a custom Marshal method for BlockID which encodes a
+// height as a JSON number and a hash as a JSON string.
+func (b BlockID) MarshalJSON() ([]byte, error) {
+	if b.IsHeight {
+		return json.Marshal(b.Height)
+	}
+	return json.Marshal(b.Hash)
+}
+
+// UnmarshalJSON provides a custom Unmarshal method for BlockID which accepts
+// either a JSON string containing a block hash or a JSON number containing a
+// block height.
+func (b *BlockID) UnmarshalJSON(data []byte) error {
+	var hash string
+	if err := json.Unmarshal(data, &hash); err == nil {
+		*b = NewBlockIDFromHash(hash)
+		return nil
+	}
+
+	var height int32
+	if err := json.Unmarshal(data, &height); err != nil {
+		return fmt.Errorf("block id must be a block hash string or a "+
+			"block height number (got %s)", data)
+	}
+	*b = NewBlockIDFromHeight(height)
+	return nil
+}
+
 // GetBlockCmd defines the getblock JSON-RPC command.
 type GetBlockCmd struct {
 	Hash      string
@@ -200,6 +259,10 @@ type TemplateRequest struct {
 	Mode         string   `json:"mode,omitempty"`
 	Capabilities []string `json:"capabilities,omitempty"`
 
+	// Optional softfork deployment rules supported by the client from
+	// BIP 0009, such as "segwit".
+	Rules []string `json:"rules,omitempty"`
+
 	// Optional long polling.
 	LongPollID string `json:"longpollid,omitempty"`
 
@@ -296,6 +359,26 @@ func NewGetCFilterCmd(hash string, filterType wire.FilterType) *GetCFilterCmd {
 	}
 }
 
+// validateParams ensures the block hash is valid and the filter type is known.
+func (c *GetCFilterCmd) validateParams() error {
+	if err := checkHashParam("hash", c.Hash); err != nil {
+		return err
+	}
+	return checkFilterTypeParam(c.FilterType)
+}
+
+// checkFilterTypeParam returns an error when the passed committed filter type
+// is not one of the known types.
+func checkFilterTypeParam(filterType wire.FilterType) error {
+	switch filterType {
+	case wire.GCSFilterRegular, wire.GCSFilterExtended:
+		return nil
+	}
+	str := fmt.Sprintf("parameter 'filtertype' is not a known filter "+
+		"type (got %d)", filterType)
+	return makeError(ErrInvalidParameter, str)
+}
+
 // GetCFilterHeaderCmd defines the getcfilterheader JSON-RPC command.
 type GetCFilterHeaderCmd struct {
 	Hash       string
@@ -312,6 +395,14 @@ func NewGetCFilterHeaderCmd(hash string,
 	}
 }
 
+// validateParams ensures the block hash is valid and the filter type is known.
+func (c *GetCFilterHeaderCmd) validateParams() error {
+	if err := checkHashParam("hash", c.Hash); err != nil {
+		return err
+	}
+	return checkFilterTypeParam(c.FilterType)
+}
+
 // GetChainTipsCmd defines the getchaintips JSON-RPC command.
 type GetChainTipsCmd struct{}
 
@@ -379,6 +470,11 @@ func NewGetMempoolEntryCmd(txHash string) *GetMempoolEntryCmd {
 	}
 }
 
+// validateParams ensures the transaction id is a valid hash.
+func (c *GetMempoolEntryCmd) validateParams() error {
+	return checkHashParam("txid", c.TxID)
+}
+
 // GetMempoolInfoCmd defines the getmempoolinfo JSON-RPC command.
 type GetMempoolInfoCmd struct{}
 
@@ -593,6 +689,11 @@ func NewPreciousBlockCmd(blockHash string) *PreciousBlockCmd {
 	}
 }
 
+// validateParams ensures the block hash is valid.
+func (c *PreciousBlockCmd) validateParams() error {
+	return checkHashParam("blockhash", c.BlockHash)
+}
+
 // ReconsiderBlockCmd defines the reconsiderblock JSON-RPC command.
 type ReconsiderBlockCmd struct {
 	BlockHash string
@@ -634,6 +735,39 @@ func NewSearchRawTransactionsCmd(address string, verbose, skip, count *int, vinE
 	}
 }
 
+// validateParams ensures the address and filter addresses are valid, that the
+// verbose and vinextra flags are either 0 or 1, and that neither the number of
+// entries to skip nor the count is negative.
+func (c *SearchRawTransactionsCmd) validateParams() error {
+	if err := checkAddressParam("address", c.Address); err != nil {
+		return err
+	}
+	if c.Verbose != nil && *c.Verbose != 0 && *c.Verbose != 1 {
+		str := fmt.Sprintf("parameter 'verbose' must be 0 or 1 (got %d)",
+			*c.Verbose)
+		return makeError(ErrInvalidParameter, str)
+	}
+	if c.Skip != nil && *c.Skip < 0 {
+		str := fmt.Sprintf("parameter 'skip' must not be negative "+
+			"(got %d)", *c.Skip)
+		return makeError(ErrInvalidParameter, str)
+	}
+	if c.Count != nil && *c.Count < 0 {
+		str := fmt.Sprintf("parameter 'count' must not be negative "+
+			"(got %d)", *c.Count)
+		return makeError(ErrInvalidParameter, str)
+	}
+	if c.VinExtra != nil && *c.VinExtra != 0 && *c.VinExtra != 1 {
+		str := fmt.Sprintf("parameter 'vinextra' must be 0 or 1 "+
+			"(got %d)", *c.VinExtra)
+		return makeError(ErrInvalidParameter, str)
+	}
+	if c.FilterAddrs != nil {
+		return checkAddressesParam("filteraddrs", *c.FilterAddrs)
+	}
+	return nil
+}
+
 // SendRawTransactionCmd defines the sendrawtransaction JSON-RPC command.
 type SendRawTransactionCmd struct {
 	HexTx         string
@@ -727,8 +861,9 @@ func NewValidateAddressCmd(address string) *ValidateAddressCmd {
 
 // VerifyChainCmd defines the verifychain JSON-RPC command.
 type VerifyChainCmd struct {
-	CheckLevel *int32 `jsonrpcdefault:"3"`
-	CheckDepth *int32 `jsonrpcdefault:"288"` // 0 = all
+	CheckLevel    *int32 `jsonrpcdefault:"3" jsonrpcnullable:"true"`
+	CheckDepth    *int32 `jsonrpcdefault:"288" jsonrpcnullable:"true"` // 0 = all
+	TimeoutMillis *int
 }
 
 // NewVerifyChainCmd returns a new instance which can be used to issue a
@@ -743,6 +878,14 @@ func NewVerifyChainCmd(checkLevel, checkDepth *int32) *VerifyChainCmd {
 	}
 }
 
+// WithTimeout sets the server-side timeout, in milliseconds, the client is
+// willing to wait for the verification to complete and returns the command so
+// calls may be chained.  The timeout is only a hint which servers may ignore.
+func (c *VerifyChainCmd) WithTimeout(ms int) *VerifyChainCmd {
+	c.TimeoutMillis = &ms
+	return c
+}
+
 // VerifyMessageCmd defines the verifymessage JSON-RPC command.
 type VerifyMessageCmd struct {
 	Address   string
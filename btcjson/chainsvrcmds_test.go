@@ -266,6 +266,28 @@ func TestChainSvrCmds(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "getblocktemplate optional - template request with rules",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("getblocktemplate", `{"mode":"template","capabilities":["longpoll"],"rules":["segwit"]}`)
+			},
+			staticCmd: func() interface{} {
+				template := btcjson.TemplateRequest{
+					Mode:         "template",
+					Capabilities: []string{"longpoll"},
+					Rules:        []string{"segwit"},
+				}
+				return btcjson.NewGetBlockTemplateCmd(&template)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getblocktemplate","params":[{"mode":"template","capabilities":["longpoll"],"rules":["segwit"]}],"id":1}`,
+			unmarshalled: &btcjson.GetBlockTemplateCmd{
+				Request: &btcjson.TemplateRequest{
+					Mode:         "template",
+					Capabilities: []string{"longpoll"},
+					Rules:        []string{"segwit"},
+				},
+			},
+		},
 		{
 			name: "getblocktemplate optional - template request with tweaks",
 			newCmd: func() (interface{}, error) {
@@ -419,14 +441,14 @@ func TestChainSvrCmds(t *testing.T) {
 		{
 			name: "getmempoolentry",
 			newCmd: func() (interface{}, error) {
-				return btcjson.NewCmd("getmempoolentry", "txhash")
+				return btcjson.NewCmd("getmempoolentry", "123")
 			},
 			staticCmd: func() interface{} {
-				return btcjson.NewGetMempoolEntryCmd("txhash")
+				return btcjson.NewGetMempoolEntryCmd("123")
 			},
-			marshalled: `{"jsonrpc":"1.0","method":"getmempoolentry","params":["txhash"],"id":1}`,
+			marshalled: `{"jsonrpc":"1.0","method":"getmempoolentry","params":["123"],"id":1}`,
 			unmarshalled: &btcjson.GetMempoolEntryCmd{
-				TxID: "txhash",
+				TxID: "123",
 			},
 		},
 		{
@@ -756,14 +778,14 @@ func TestChainSvrCmds(t *testing.T) {
 		{
 			name: "searchrawtransactions",
 			newCmd: func() (interface{}, error) {
-				return btcjson.NewCmd("searchrawtransactions", "1Address")
+				return btcjson.NewCmd("searchrawtransactions", "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2")
 			},
 			staticCmd: func() interface{} {
-				return btcjson.NewSearchRawTransactionsCmd("1Address", nil, nil, nil, nil, nil, nil)
+				return btcjson.NewSearchRawTransactionsCmd("1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2", nil, nil, nil, nil, nil, nil)
 			},
-			marshalled: `{"jsonrpc":"1.0","method":"searchrawtransactions","params":["1Address"],"id":1}`,
+			marshalled: `{"jsonrpc":"1.0","method":"searchrawtransactions","params":["1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"],"id":1}`,
 			unmarshalled: &btcjson.SearchRawTransactionsCmd{
-				Address:     "1Address",
+				Address:     "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2",
 				Verbose:     btcjson.Int(1),
 				Skip:        btcjson.Int(0),
 				Count:       btcjson.Int(100),
@@ -775,15 +797,15 @@ func TestChainSvrCmds(t *testing.T) {
 		{
 			name: "searchrawtransactions",
 			newCmd: func() (interface{}, error) {
-				return btcjson.NewCmd("searchrawtransactions", "1Address", 0)
+				return btcjson.NewCmd("searchrawtransactions", "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2", 0)
 			},
 			staticCmd: func() interface{} {
-				return btcjson.NewSearchRawTransactionsCmd("1Address",
+				return btcjson.NewSearchRawTransactionsCmd("1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2",
 					btcjson.Int(0), nil, nil, nil, nil, nil)
 			},
-			marshalled: `{"jsonrpc":"1.0","method":"searchrawtransactions","params":["1Address",0],"id":1}`,
+			marshalled: `{"jsonrpc":"1.0","method":"searchrawtransactions","params":["1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2",0],"id":1}`,
 			unmarshalled: &btcjson.SearchRawTransactionsCmd{
-				Address:     "1Address",
+				Address:     "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2",
 				Verbose:     btcjson.Int(0),
 				Skip:        btcjson.Int(0),
 				Count:       btcjson.Int(100),
@@ -795,15 +817,15 @@ func TestChainSvrCmds(t *testing.T) {
 		{
 			name: "searchrawtransactions",
 			newCmd: func() (interface{}, error) {
-				return btcjson.NewCmd("searchrawtransactions", "1Address", 0, 5)
+				return btcjson.NewCmd("searchrawtransactions", "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2", 0, 5)
 			},
 			staticCmd: func() interface{} {
-				return btcjson.NewSearchRawTransactionsCmd("1Address",
+				return btcjson.NewSearchRawTransactionsCmd("1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2",
 					btcjson.Int(0), btcjson.Int(5), nil, nil, nil, nil)
 			},
-			marshalled: `{"jsonrpc":"1.0","method":"searchrawtransactions","params":["1Address",0,5],"id":1}`,
+			marshalled: `{"jsonrpc":"1.0","method":"searchrawtransactions","params":["1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2",0,5],"id":1}`,
 			unmarshalled: &btcjson.SearchRawTransactionsCmd{
-				Address:     "1Address",
+				Address:     "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2",
 				Verbose:     btcjson.Int(0),
 				Skip:        btcjson.Int(5),
 				Count:       btcjson.Int(100),
@@ -815,15 +837,15 @@ func TestChainSvrCmds(t *testing.T) {
 		{
 			name: "searchrawtransactions",
 			newCmd: func() (interface{}, error) {
-				return btcjson.NewCmd("searchrawtransactions", "1Address", 0, 5, 10)
+				return btcjson.NewCmd("searchrawtransactions", "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2", 0, 5, 10)
 			},
 			staticCmd: func() interface{} {
-				return btcjson.NewSearchRawTransactionsCmd("1Address",
+				return btcjson.NewSearchRawTransactionsCmd("1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2",
 					btcjson.Int(0), btcjson.Int(5), btcjson.Int(10), nil, nil, nil)
 			},
-			marshalled: `{"jsonrpc":"1.0","method":"searchrawtransactions","params":["1Address",0,5,10],"id":1}`,
+			marshalled: `{"jsonrpc":"1.0","method":"searchrawtransactions","params":["1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2",0,5,10],"id":1}`,
 			unmarshalled: &btcjson.SearchRawTransactionsCmd{
-				Address:     "1Address",
+				Address:     "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2",
 				Verbose:     btcjson.Int(0),
 				Skip:        btcjson.Int(5),
 				Count:       btcjson.Int(10),
@@ -835,15 +857,15 @@ func TestChainSvrCmds(t *testing.T) {
 		{
 			name: "searchrawtransactions",
 			newCmd: func() (interface{}, error) {
-				return btcjson.NewCmd("searchrawtransactions", "1Address", 0, 5, 10, 1)
+				return btcjson.NewCmd("searchrawtransactions", "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2", 0, 5, 10, 1)
 			},
 			staticCmd: func() interface{} {
-				return btcjson.NewSearchRawTransactionsCmd("1Address",
+				return btcjson.NewSearchRawTransactionsCmd("1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2",
 					btcjson.Int(0), btcjson.Int(5), btcjson.Int(10), btcjson.Int(1), nil, nil)
 			},
-			marshalled: `{"jsonrpc":"1.0","method":"searchrawtransactions","params":["1Address",0,5,10,1],"id":1}`,
+			marshalled: `{"jsonrpc":"1.0","method":"searchrawtransactions","params":["1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2",0,5,10,1],"id":1}`,
 			unmarshalled: &btcjson.SearchRawTransactionsCmd{
-				Address:     "1Address",
+				Address:     "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2",
 				Verbose:     btcjson.Int(0),
 				Skip:        btcjson.Int(5),
 				Count:       btcjson.Int(10),
@@ -855,15 +877,15 @@ func TestChainSvrCmds(t *testing.T) {
 		{
 			name: "searchrawtransactions",
 			newCmd: func() (interface{}, error) {
-				return btcjson.NewCmd("searchrawtransactions", "1Address", 0, 5, 10, 1, true)
+				return btcjson.NewCmd("searchrawtransactions", "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2", 0, 5, 10, 1, true)
 			},
 			staticCmd: func() interface{} {
-				return btcjson.NewSearchRawTransactionsCmd("1Address",
+				return btcjson.NewSearchRawTransactionsCmd("1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2",
 					btcjson.Int(0), btcjson.Int(5), btcjson.Int(10), btcjson.Int(1), btcjson.Bool(true), nil)
 			},
-			marshalled: `{"jsonrpc":"1.0","method":"searchrawtransactions","params":["1Address",0,5,10,1,true],"id":1}`,
+			marshalled: `{"jsonrpc":"1.0","method":"searchrawtransactions","params":["1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2",0,5,10,1,true],"id":1}`,
 			unmarshalled: &btcjson.SearchRawTransactionsCmd{
-				Address:     "1Address",
+				Address:     "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2",
 				Verbose:     btcjson.Int(0),
 				Skip:        btcjson.Int(5),
 				Count:       btcjson.Int(10),
@@ -875,21 +897,21 @@ func TestChainSvrCmds(t *testing.T) {
 		{
 			name: "searchrawtransactions",
 			newCmd: func() (interface{}, error) {
-				return btcjson.NewCmd("searchrawtransactions", "1Address", 0, 5, 10, 1, true, []string{"1Address"})
+				return btcjson.NewCmd("searchrawtransactions", "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2", 0, 5, 10, 1, true, []string{"1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"})
 			},
 			staticCmd: func() interface{} {
-				return btcjson.NewSearchRawTransactionsCmd("1Address",
-					btcjson.Int(0), btcjson.Int(5), btcjson.Int(10), btcjson.Int(1), btcjson.Bool(true), &[]string{"1Address"})
+				return btcjson.NewSearchRawTransactionsCmd("1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2",
+					btcjson.Int(0), btcjson.Int(5), btcjson.Int(10), btcjson.Int(1), btcjson.Bool(true), &[]string{"1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"})
 			},
-			marshalled: `{"jsonrpc":"1.0","method":"searchrawtransactions","params":["1Address",0,5,10,1,true,["1Address"]],"id":1}`,
+			marshalled: `{"jsonrpc":"1.0","method":"searchrawtransactions","params":["1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2",0,5,10,1,true,["1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"]],"id":1}`,
 			unmarshalled: &btcjson.SearchRawTransactionsCmd{
-				Address:     "1Address",
+				Address:     "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2",
 				Verbose:     btcjson.Int(0),
 				Skip:        btcjson.Int(5),
 				Count:       btcjson.Int(10),
 				VinExtra:    btcjson.Int(1),
 				Reverse:     btcjson.Bool(true),
-				FilterAddrs: &[]string{"1Address"},
+				FilterAddrs: &[]string{"1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"},
 			},
 		},
 		{
@@ -1058,6 +1080,36 @@ func TestChainSvrCmds(t *testing.T) {
 				CheckDepth: btcjson.Int32(500),
 			},
 		},
+		{
+			name: "verifychain timeout",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("verifychain", 2, 500, 60000)
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewVerifyChainCmd(btcjson.Int32(2), btcjson.Int32(500)).WithTimeout(60000)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"verifychain","params":[2,500,60000],"id":1}`,
+			unmarshalled: &btcjson.VerifyChainCmd{
+				CheckLevel:    btcjson.Int32(2),
+				CheckDepth:    btcjson.Int32(500),
+				TimeoutMillis: btcjson.Int(60000),
+			},
+		},
+		{
+			name: "verifychain timeout only",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("verifychain", nil, nil, 60000)
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewVerifyChainCmd(nil, nil).WithTimeout(60000)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"verifychain","params":[null,null,60000],"id":1}`,
+			unmarshalled: &btcjson.VerifyChainCmd{
+				CheckLevel:    btcjson.Int32(3),
+				CheckDepth:    btcjson.Int32(288),
+				TimeoutMillis: btcjson.Int(60000),
+			},
+		},
 		{
 			name: "verifymessage",
 			newCmd: func() (interface{}, error) {
@@ -1107,6 +1159,13 @@ func TestChainSvrCmds(t *testing.T) {
 			continue
 		}
 
+		// Ensure the params reported for the command match the
+		// marshalled params.
+		if err := checkCmdParams(test.staticCmd(), marshalled); err != nil {
+			t.Errorf("Test #%d (%s) %v", i, test.name, err)
+			continue
+		}
+
 		// Ensure the command is created without error via the generic
 		// new command creation function.
 		cmd, err := test.newCmd()
@@ -1207,3 +1266,253 @@ func TestChainSvrCmdErrors(t *testing.T) {
 		}
 	}
 }
+
+// TestBlockID ensures a BlockID decodes from either a JSON string hash or a
+// JSON number height, records which form it got, and round trips.
+func TestBlockID(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		json     string
+		expected btcjson.BlockID
+		wantErr  bool
+	}{
+		{
+			name:     "hash",
+			json:     `"000000000019d6689c085ae165831e934ff763ae46a2a6c172b3f1b60a8ce26f"`,
+			expected: btcjson.NewBlockIDFromHash("000000000019d6689c085ae165831e934ff763ae46a2a6c172b3f1b60a8ce26f"),
+		},
+		{
+			name:     "height",
+			json:     `123`,
+			expected: btcjson.NewBlockIDFromHeight(123),
+		},
+		{
+			name:     "zero height",
+			json:     `0`,
+			expected: btcjson.NewBlockIDFromHeight(0),
+		},
+		{
+			name:    "boolean",
+			json:    `true`,
+			wantErr: true,
+		},
+		{
+			name:    "fractional height",
+			json:    `1.5`,
+			wantErr: true,
+		},
+		{
+			name:    "height overflows int32",
+			json:    `2147483648`,
+			wantErr: true,
+		},
+	}
+
+	t.Logf("Running %d tests", len(tests))
+	for i, test := range tests {
+		var id btcjson.BlockID
+		err := json.Unmarshal([]byte(test.json), &id)
+		if test.wantErr {
+			if err == nil {
+				t.Errorf("Test #%d (%s) unexpected success", i,
+					test.name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Test #%d (%s) unexpected error: %v", i,
+				test.name, err)
+			continue
+		}
+		if id != test.expected {
+			t.Errorf("Test #%d (%s) unexpected block id - got %+v, "+
+				"want %+v", i, test.name, id, test.expected)
+			continue
+		}
+		if id.IsHeight != test.expected.IsHeight {
+			t.Errorf("Test #%d (%s) unexpected discriminant - "+
+				"got %v, want %v", i, test.name, id.IsHeight,
+				test.expected.IsHeight)
+			continue
+		}
+
+		marshalled, err := json.Marshal(id)
+		if err != nil {
+			t.Errorf("Test #%d (%s) unexpected marshal error: %v",
+				i, test.name, err)
+			continue
+		}
+		if string(marshalled) != test.json {
+			t.Errorf("Test #%d (%s) unexpected marshalled data - "+
+				"got %s, want %s", i, test.name, marshalled,
+				test.json)
+			continue
+		}
+	}
+}
+
+// TestChainSvrCmdParamErrors ensures UnmarshalCmd rejects chain server commands
+// whose parameters do not satisfy the requirements of the command.
+func TestChainSvrCmdParamErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []unmarshalCmdErrorTest{
+		{
+			name: "params for gethashespersec",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "gethashespersec",
+				Params:  []json.RawMessage{[]byte(`1`)},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrTooManyParams},
+		},
+		{
+			name: "invalid rules type for getblocktemplate",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "getblocktemplate",
+				Params:  []json.RawMessage{[]byte(`{"rules":"segwit"}`)},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidType},
+		},
+		{
+			name: "params for uptime",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "uptime",
+				Params:  []json.RawMessage{[]byte(`1`)},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrTooManyParams},
+		},
+		{
+			name: "invalid hash for getcfilter",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "getcfilter",
+				Params:  []json.RawMessage{[]byte(`"xyz"`), []byte(`0`)},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name: "unknown filter type for getcfilter",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "getcfilter",
+				Params:  []json.RawMessage{[]byte(`"123"`), []byte(`2`)},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name: "invalid hash for getcfilterheader",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "getcfilterheader",
+				Params:  []json.RawMessage{[]byte(`"xyz"`), []byte(`0`)},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name: "unknown filter type for getcfilterheader",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "getcfilterheader",
+				Params:  []json.RawMessage{[]byte(`"123"`), []byte(`2`)},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name: "invalid address for searchrawtransactions",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "searchrawtransactions",
+				Params:  []json.RawMessage{[]byte(`"1Address"`)},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name: "invalid verbose for searchrawtransactions",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "searchrawtransactions",
+				Params:  []json.RawMessage{[]byte(`"1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"`), []byte(`2`)},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name: "negative skip for searchrawtransactions",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "searchrawtransactions",
+				Params: []json.RawMessage{[]byte(`"1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"`), []byte(`1`),
+					[]byte(`-1`)},
+				ID: nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name: "negative count for searchrawtransactions",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "searchrawtransactions",
+				Params: []json.RawMessage{[]byte(`"1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"`), []byte(`1`),
+					[]byte(`0`), []byte(`-1`)},
+				ID: nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name: "invalid filter address for searchrawtransactions",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "searchrawtransactions",
+				Params: []json.RawMessage{[]byte(`"1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"`), []byte(`1`),
+					[]byte(`0`), []byte(`100`), []byte(`0`), []byte(`false`),
+					[]byte(`["1Address"]`)},
+				ID: nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name: "malformed hash for preciousblock",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "preciousblock",
+				Params:  []json.RawMessage{[]byte(`"xyz"`)},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name: "getmempoolentry invalid txid",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "getmempoolentry",
+				Params:  []json.RawMessage{[]byte(`"txhash"`)},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name: "fractional height for getblock",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "getblock",
+				Params:  []json.RawMessage{[]byte("1.5")},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidType},
+		},
+	}
+	testUnmarshalCmdErrors(t, tests)
+}
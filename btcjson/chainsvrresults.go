@@ -4,7 +4,10 @@
 
 package btcjson
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"fmt"
+)
 
 // GetBlockHeaderVerboseResult models the data from the getblockheader command when
 // the verbose flag is set.  When the verbose flag is not set, getblockheader
@@ -47,6 +50,38 @@ type GetBlockVerboseResult struct {
 	NextHash      string        `json:"nextblockhash,omitempty"`
 }
 
+// UnmarshalJSON unmarshals the getblock result and ensures every decoded
+// transaction, which is only present when verbosetx is set, identifies itself
+// and each of its inputs is either a coinbase or references a previous
+// transaction.
+func (r *GetBlockVerboseResult) UnmarshalJSON(data []byte) error {
+	// Use a distinct type without this method to avoid infinite recursion.
+	type blockResult GetBlockVerboseResult
+	var result blockResult
+	if err := json.Unmarshal(data, &result); err != nil {
+		return err
+	}
+
+	for i, tx := range result.RawTx {
+		if tx.Txid == "" {
+			return fmt.Errorf("getblock result transaction %d is "+
+				"missing its txid", i)
+		}
+		for j := range tx.Vin {
+			vin := &tx.Vin[j]
+			if !vin.IsCoinBase() && vin.Txid == "" {
+				return fmt.Errorf("getblock result transaction "+
+					"%s input %d is neither a coinbase nor "+
+					"references a previous transaction",
+					tx.Txid, j)
+			}
+		}
+	}
+
+	*r = GetBlockVerboseResult(result)
+	return nil
+}
+
 // CreateMultiSigResult models the data returned from the createmultisig
 // command.
 type CreateMultiSigResult struct {
@@ -195,8 +230,11 @@ type GetMempoolEntryResult struct {
 // GetMempoolInfoResult models the data returned from the getmempoolinfo
 // command.
 type GetMempoolInfoResult struct {
-	Size  int64 `json:"size"`
-	Bytes int64 `json:"bytes"`
+	Size          int64   `json:"size"`
+	Bytes         int64   `json:"bytes"`
+	Usage         int64   `json:"usage,omitempty"`
+	MaxMempool    int64   `json:"maxmempool,omitempty"`
+	MempoolMinFee float64 `json:"mempoolminfee"`
 }
 
 // NetworksResult models the networks data from the getnetworkinfo command.
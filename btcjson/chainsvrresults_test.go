@@ -6,6 +6,7 @@ package btcjson_test
 
 import (
 	"encoding/json"
+	"reflect"
 	"testing"
 
 	"github.com/btcsuite/btcd/btcjson"
@@ -86,3 +87,173 @@ func TestChainSvrCustomResults(t *testing.T) {
 		}
 	}
 }
+
+// resultDecodeTest describes a raw JSON result along with the value it is
+// expected to decode to, or whether decoding it must fail.
+type resultDecodeTest struct {
+	name     string
+	result   string
+	expected interface{}
+	wantErr  bool
+}
+
+// testResultDecode unmarshals the result of each test into the value returned
+// by newResult, which must be a pointer, and ensures it either fails when
+// expected or produces the expected value.
+func testResultDecode(t *testing.T, tests []resultDecodeTest,
+	newResult func() interface{}) {
+
+	t.Helper()
+
+	t.Logf("Running %d tests", len(tests))
+	for i, test := range tests {
+		result := newResult()
+		err := json.Unmarshal([]byte(test.result), result)
+		if test.wantErr {
+			if err == nil {
+				t.Errorf("Test #%d (%s) unexpected success", i,
+					test.name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Test #%d (%s) unexpected error: %v", i,
+				test.name, err)
+			continue
+		}
+		got := reflect.ValueOf(result).Elem().Interface()
+		if !reflect.DeepEqual(got, test.expected) {
+			t.Errorf("Test #%d (%s) unexpected result - got %+v, "+
+				"want %+v", i, test.name, got, test.expected)
+		}
+	}
+}
+
+// TestGetBlockVerboseResultRawTx ensures a getblock result with verbosetx set,
+// in the shape btcd returns it, decodes the full transaction list and rejects
+// transactions which are missing their identifying fields.
+func TestGetBlockVerboseResultRawTx(t *testing.T) {
+	t.Parallel()
+
+	const header = `"hash":"00000000000000000001","confirmations":10,` +
+		`"strippedsize":200,"size":250,"weight":850,"height":600000,` +
+		`"version":536870912,"versionHex":"20000000",` +
+		`"merkleroot":"abcd","time":1573000000,"nonce":12345,` +
+		`"bits":"17148edf","difficulty":12720005267390.5,` +
+		`"previousblockhash":"00000000000000000000",` +
+		`"nextblockhash":"00000000000000000002"`
+	const coinbaseTx = `{"hex":"01","txid":"aa","hash":"aa","size":100,` +
+		`"vsize":100,"version":1,"locktime":0,"vin":[{"coinbase":"03",` +
+		`"sequence":4294967295}],"vout":[{"value":12.5,"n":0,` +
+		`"scriptPubKey":{"asm":"","hex":"51","type":"nonstandard"}}]}`
+	const spendTx = `{"hex":"02","txid":"bb","hash":"bc","size":150,` +
+		`"vsize":120,"version":2,"locktime":599999,"vin":[{"txid":"cc",` +
+		`"vout":1,"scriptSig":{"asm":"","hex":""},"txinwitness":["30"],` +
+		`"sequence":4294967294}],"vout":[{"value":0.5,"n":0,` +
+		`"scriptPubKey":{"asm":"","hex":"0014","type":"witness_v0_keyhash",` +
+		`"reqSigs":1,"addresses":["bc1q"]}}]}`
+	result := `{` + header + `,"rawtx":[` + coinbaseTx + `,` + spendTx + `]}`
+
+	want := btcjson.GetBlockVerboseResult{
+		Hash:          "00000000000000000001",
+		Confirmations: 10,
+		StrippedSize:  200,
+		Size:          250,
+		Weight:        850,
+		Height:        600000,
+		Version:       536870912,
+		VersionHex:    "20000000",
+		MerkleRoot:    "abcd",
+		RawTx: []btcjson.TxRawResult{{
+			Hex:     "01",
+			Txid:    "aa",
+			Hash:    "aa",
+			Size:    100,
+			Vsize:   100,
+			Version: 1,
+			Vin: []btcjson.Vin{{
+				Coinbase: "03",
+				Sequence: 4294967295,
+			}},
+			Vout: []btcjson.Vout{{
+				Value: 12.5,
+				ScriptPubKey: btcjson.ScriptPubKeyResult{
+					Hex:  "51",
+					Type: "nonstandard",
+				},
+			}},
+		}, {
+			Hex:      "02",
+			Txid:     "bb",
+			Hash:     "bc",
+			Size:     150,
+			Vsize:    120,
+			Version:  2,
+			LockTime: 599999,
+			Vin: []btcjson.Vin{{
+				Txid:      "cc",
+				Vout:      1,
+				ScriptSig: &btcjson.ScriptSig{},
+				Witness:   []string{"30"},
+				Sequence:  4294967294,
+			}},
+			Vout: []btcjson.Vout{{
+				Value: 0.5,
+				ScriptPubKey: btcjson.ScriptPubKeyResult{
+					Hex:       "0014",
+					ReqSigs:   1,
+					Type:      "witness_v0_keyhash",
+					Addresses: []string{"bc1q"},
+				},
+			}},
+		}},
+		Time:         1573000000,
+		Nonce:        12345,
+		Bits:         "17148edf",
+		Difficulty:   12720005267390.5,
+		PreviousHash: "00000000000000000000",
+		NextHash:     "00000000000000000002",
+	}
+
+	var block btcjson.GetBlockVerboseResult
+	if err := json.Unmarshal([]byte(result), &block); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(block, want) {
+		t.Fatalf("unexpected result - got %+v, want %+v", block, want)
+	}
+
+	// Ensure the reply as marshalled by the server decodes back to itself.
+	marshalled, err := json.Marshal(want)
+	if err != nil {
+		t.Fatalf("unexpected error marshalling result: %v", err)
+	}
+	block = btcjson.GetBlockVerboseResult{}
+	if err := json.Unmarshal(marshalled, &block); err != nil {
+		t.Fatalf("unexpected error decoding %s: %v", marshalled, err)
+	}
+	if !reflect.DeepEqual(block, want) {
+		t.Fatalf("unexpected round trip result - got %+v, want %+v",
+			block, want)
+	}
+
+	badTxs := []string{
+		// Transaction without a txid.
+		`{"hex":"01","version":1,"locktime":0,"vin":[],"vout":[]}`,
+
+		// Input which is neither a coinbase nor spends an output.
+		`{"hex":"01","txid":"aa","version":1,"locktime":0,` +
+			`"vin":[{"vout":0,"sequence":0}],"vout":[]}`,
+
+		// Transaction of the wrong type.
+		`"aa"`,
+	}
+	for _, badTx := range badTxs {
+		result := `{` + header + `,"rawtx":[` + coinbaseTx + `,` + badTx +
+			`]}`
+		var block btcjson.GetBlockVerboseResult
+		if err := json.Unmarshal([]byte(result), &block); err == nil {
+			t.Fatalf("unexpected success decoding tx %s", badTx)
+		}
+	}
+}
@@ -8,7 +8,15 @@
 
 package btcjson
 
-// AuthenticateCmd defines the authenticate JSON-RPC command.
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/btcsuite/btcd/wire"
+)
+
+// AuthenticateCmd defines the authenticate JSON-RPC command.  Its String method
+// redacts the passphrase.
 type AuthenticateCmd struct {
 	Username   string
 	Passphrase string
@@ -23,7 +31,72 @@ func NewAuthenticateCmd(username, passphrase string) *AuthenticateCmd {
 	}
 }
 
-// NotifyBlocksCmd defines the notifyblocks JSON-RPC command.
+// String returns a description of the command with the passphrase masked so
+// the command can be safely logged.
+func (c AuthenticateCmd) String() string {
+	return fmt.Sprintf("authenticate(username=%q, passphrase=<redacted>)",
+		c.Username)
+}
+
+// validateParams ensures the passphrase is within the maximum length.
+func (c *AuthenticateCmd) validateParams() error {
+	return checkPassphraseParam("passphrase", c.Passphrase)
+}
+
+// ClearBannedCmd defines the clearbanned JSON-RPC command.
+type ClearBannedCmd struct{}
+
+// NewClearBannedCmd returns a new instance which can be used to issue a
+// clearbanned JSON-RPC command.
+func NewClearBannedCmd() *ClearBannedCmd {
+	return &ClearBannedCmd{}
+}
+
+// ClearNotificationsCmd defines the clearnotifications JSON-RPC command.
+type ClearNotificationsCmd struct{}
+
+// NewClearNotificationsCmd returns a new instance which can be used to issue a
+// clearnotifications JSON-RPC command.
+func NewClearNotificationsCmd() *ClearNotificationsCmd {
+	return &ClearNotificationsCmd{}
+}
+
+// CombineRawTransactionCmd defines the combinerawtransaction JSON-RPC command.
+// It combines the signatures of several partially signed versions of the same
+// transaction, each of which is hex-encoded.
+type CombineRawTransactionCmd struct {
+	Txs []string
+}
+
+// NewCombineRawTransactionCmd returns a new instance which can be used to issue
+// a combinerawtransaction JSON-RPC command.
+func NewCombineRawTransactionCmd(txs []string) *CombineRawTransactionCmd {
+	return &CombineRawTransactionCmd{
+		Txs: txs,
+	}
+}
+
+// validateParams ensures at least one transaction is provided and that each of
+// them is a hex-encoded serialized transaction.
+func (c *CombineRawTransactionCmd) validateParams() error {
+	if len(c.Txs) == 0 {
+		return makeError(ErrInvalidParameter, "parameter 'txs' must "+
+			"contain at least one transaction")
+	}
+	for i, txHex := range c.Txs {
+		err := checkTxParam(fmt.Sprintf("txs[%d]", i), txHex)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NotifyBlocksCmd defines the notifyblocks JSON-RPC command.  Servers which
+// support it reply with a NotifyBlocksResult describing the best block at the
+// time of the subscription, so a client can compare it with the last block it
+// processed to detect any blocks it missed before the first notification
+// arrives.  Other servers reply with null.
 type NotifyBlocksCmd struct{}
 
 // NewNotifyBlocksCmd returns a new instance which can be used to issue a
@@ -32,6 +105,62 @@ func NewNotifyBlocksCmd() *NotifyBlocksCmd {
 	return &NotifyBlocksCmd{}
 }
 
+// SetBanSubCmd defines the type used in the setban JSON-RPC command for the
+// sub command field.
+type SetBanSubCmd string
+
+const (
+	// SBAdd indicates the specified subnet should be added to the ban
+	// list.
+	SBAdd SetBanSubCmd = "add"
+
+	// SBRemove indicates the specified subnet should be removed from the
+	// ban list.
+	SBRemove SetBanSubCmd = "remove"
+)
+
+// UnmarshalJSON unmarshals the sub command from a JSON string and ensures it
+// is one of the supported values.
+func (c *SetBanSubCmd) UnmarshalJSON(data []byte) error {
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return err
+	}
+	switch subCmd := SetBanSubCmd(str); subCmd {
+	case SBAdd, SBRemove:
+		*c = subCmd
+		return nil
+	}
+	return fmt.Errorf("invalid sub command %q, must be %q or %q", str,
+		SBAdd, SBRemove)
+}
+
+// SetBanCmd defines the setban JSON-RPC command.  The ban time is specified
+// in seconds, or as a unix timestamp when Absolute is set, and therefore can
+// not be negative.
+type SetBanCmd struct {
+	Subnet   string
+	SubCmd   SetBanSubCmd `jsonrpcusage:"\"add|remove\""`
+	BanTime  *uint32      `jsonrpcdefault:"0"`
+	Absolute *bool        `jsonrpcdefault:"false"`
+}
+
+// NewSetBanCmd returns a new instance which can be used to issue a setban
+// JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewSetBanCmd(subnet string, subCmd SetBanSubCmd, banTime *uint32,
+	absolute *bool) *SetBanCmd {
+
+	return &SetBanCmd{
+		Subnet:   subnet,
+		SubCmd:   subCmd,
+		BanTime:  banTime,
+		Absolute: absolute,
+	}
+}
+
 // StopNotifyBlocksCmd defines the stopnotifyblocks JSON-RPC command.
 type StopNotifyBlocksCmd struct{}
 
@@ -57,6 +186,26 @@ func NewNotifyNewTransactionsCmd(verbose *bool) *NotifyNewTransactionsCmd {
 	}
 }
 
+// NotifyNewTXsVerboseCmd defines the notifynewtxsverbose JSON-RPC command.  It
+// requests verbosetx notifications, which carry the fully decoded transaction,
+// for transactions paying to any of the addresses.
+type NotifyNewTXsVerboseCmd struct {
+	Addresses []string
+}
+
+// NewNotifyNewTXsVerboseCmd returns a new instance which can be used to issue
+// a notifynewtxsverbose JSON-RPC command.
+func NewNotifyNewTXsVerboseCmd(addresses []string) *NotifyNewTXsVerboseCmd {
+	return &NotifyNewTXsVerboseCmd{
+		Addresses: addresses,
+	}
+}
+
+// validateParams ensures all of the addresses are valid.
+func (c *NotifyNewTXsVerboseCmd) validateParams() error {
+	return checkAddressesParam("addresses", c.Addresses)
+}
+
 // SessionCmd defines the session JSON-RPC command.
 type SessionCmd struct{}
 
@@ -102,6 +251,326 @@ type OutPoint struct {
 	Index uint32 `json:"index"`
 }
 
+// FilterAddCmd defines the filteradd JSON-RPC command.  It adds a data element
+// to the bloom filter previously loaded for the websocket client.
+type FilterAddCmd struct {
+	Data string
+}
+
+// NewFilterAddCmd returns a new instance which can be used to issue a
+// filteradd JSON-RPC command.
+func NewFilterAddCmd(data string) *FilterAddCmd {
+	return &FilterAddCmd{
+		Data: data,
+	}
+}
+
+// validateParams ensures the data element is hex-encoded and no larger than
+// allowed by the filteradd wire message.
+func (c *FilterAddCmd) validateParams() error {
+	data, err := decodeHexParam("data", c.Data)
+	if err != nil {
+		return err
+	}
+	if len(data) > wire.MaxFilterAddDataSize {
+		str := fmt.Sprintf("parameter 'data' is %d bytes which "+
+			"exceeds the max of %d", len(data),
+			wire.MaxFilterAddDataSize)
+		return makeError(ErrInvalidParameter, str)
+	}
+	return nil
+}
+
+// FilterClearCmd defines the filterclear JSON-RPC command.  It removes the
+// bloom filter loaded for the websocket client.
+type FilterClearCmd struct{}
+
+// NewFilterClearCmd returns a new instance which can be used to issue a
+// filterclear JSON-RPC command.
+func NewFilterClearCmd() *FilterClearCmd {
+	return &FilterClearCmd{}
+}
+
+// FilterLoadCmd defines the filterload JSON-RPC command.  It loads a bloom
+// filter, as described by BIP0037, for the websocket client.
+type FilterLoadCmd struct {
+	Filter    string
+	HashFuncs uint32
+	Tweak     uint32
+	Flags     uint8
+}
+
+// NewFilterLoadCmd returns a new instance which can be used to issue a
+// filterload JSON-RPC command.
+func NewFilterLoadCmd(filter string, hashFuncs, tweak uint32, flags uint8) *FilterLoadCmd {
+	return &FilterLoadCmd{
+		Filter:    filter,
+		HashFuncs: hashFuncs,
+		Tweak:     tweak,
+		Flags:     flags,
+	}
+}
+
+// validateParams ensures the filter is hex-encoded and that its size, the
+// number of hash functions and the update flags are within the limits imposed
+// by the filterload wire message.
+func (c *FilterLoadCmd) validateParams() error {
+	filter, err := decodeHexParam("filter", c.Filter)
+	if err != nil {
+		return err
+	}
+	if len(filter) > wire.MaxFilterLoadFilterSize {
+		str := fmt.Sprintf("parameter 'filter' is %d bytes which "+
+			"exceeds the max of %d", len(filter),
+			wire.MaxFilterLoadFilterSize)
+		return makeError(ErrInvalidParameter, str)
+	}
+	if c.HashFuncs > wire.MaxFilterLoadHashFuncs {
+		str := fmt.Sprintf("parameter 'hashfuncs' (%d) exceeds the "+
+			"max of %d", c.HashFuncs, wire.MaxFilterLoadHashFuncs)
+		return makeError(ErrInvalidParameter, str)
+	}
+	if wire.BloomUpdateType(c.Flags) > wire.BloomUpdateP2PubkeyOnly {
+		str := fmt.Sprintf("parameter 'flags' (%d) is not a known "+
+			"bloom update type", c.Flags)
+		return makeError(ErrInvalidParameter, str)
+	}
+	return nil
+}
+
+// GetAddressBalanceCmd defines the getaddressbalance JSON-RPC command.  It
+// requests the combined balance and total received amount of the addresses
+// from the address index.
+type GetAddressBalanceCmd struct {
+	Addresses []string
+}
+
+// NewGetAddressBalanceCmd returns a new instance which can be used to issue a
+// getaddressbalance JSON-RPC command.
+func NewGetAddressBalanceCmd(addresses []string) *GetAddressBalanceCmd {
+	return &GetAddressBalanceCmd{
+		Addresses: addresses,
+	}
+}
+
+// validateParams ensures each of the addresses is valid.
+func (c *GetAddressBalanceCmd) validateParams() error {
+	return checkAddressesParam("addresses", c.Addresses)
+}
+
+// checkHeightRange returns an error when both the start and end heights of an
+// address index query are provided and the range is reversed.
+func checkHeightRange(start, end *int32) error {
+	if start != nil && end != nil && *start > *end {
+		str := fmt.Sprintf("parameter 'start' (%d) must not be "+
+			"greater than parameter 'end' (%d)", *start, *end)
+		return makeError(ErrInvalidParameter, str)
+	}
+	return nil
+}
+
+// GetAddressDeltasCmd defines the getaddressdeltas JSON-RPC command.  It
+// requests every change to the balances of the addresses from the address
+// index, optionally limited to the blocks between the Start and End heights.
+// When ChainInfo is set, the deltas are returned along with the hashes and
+// heights of the start and end blocks rather than as a plain array.
+type GetAddressDeltasCmd struct {
+	Addresses []string
+	Start     *int32
+	End       *int32
+	ChainInfo *bool
+}
+
+// NewGetAddressDeltasCmd returns a new instance which can be used to issue a
+// getaddressdeltas JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will omit them from the request.  Since parameters
+// are positional, each one is only sent when all of those before it are also
+// provided.
+func NewGetAddressDeltasCmd(addresses []string, start, end *int32,
+	chainInfo *bool) *GetAddressDeltasCmd {
+
+	return &GetAddressDeltasCmd{
+		Addresses: addresses,
+		Start:     start,
+		End:       end,
+		ChainInfo: chainInfo,
+	}
+}
+
+// validateParams ensures each of the addresses is valid and that the height
+// range, when fully specified, is not reversed.
+func (c *GetAddressDeltasCmd) validateParams() error {
+	if err := checkAddressesParam("addresses", c.Addresses); err != nil {
+		return err
+	}
+	return checkHeightRange(c.Start, c.End)
+}
+
+// GetAddressMempoolCmd defines the getaddressmempool JSON-RPC command.  It
+// requests the balance changes of the addresses caused by transactions in the
+// memory pool from the address index.
+type GetAddressMempoolCmd struct {
+	Addresses []string
+}
+
+// NewGetAddressMempoolCmd returns a new instance which can be used to issue a
+// getaddressmempool JSON-RPC command.
+func NewGetAddressMempoolCmd(addresses []string) *GetAddressMempoolCmd {
+	return &GetAddressMempoolCmd{
+		Addresses: addresses,
+	}
+}
+
+// validateParams ensures each of the addresses is valid.
+func (c *GetAddressMempoolCmd) validateParams() error {
+	return checkAddressesParam("addresses", c.Addresses)
+}
+
+// GetAddressTxidsCmd defines the getaddresstxids JSON-RPC command.  It
+// requests the hashes of the transactions involving the addresses from the
+// address index, optionally limited to the blocks between the Start and End
+// heights.
+type GetAddressTxidsCmd struct {
+	Addresses []string
+	Start     *int32
+	End       *int32
+}
+
+// NewGetAddressTxidsCmd returns a new instance which can be used to issue a
+// getaddresstxids JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will omit them from the request.  Since parameters
+// are positional, end is only sent when start is also provided.
+func NewGetAddressTxidsCmd(addresses []string, start, end *int32) *GetAddressTxidsCmd {
+	return &GetAddressTxidsCmd{
+		Addresses: addresses,
+		Start:     start,
+		End:       end,
+	}
+}
+
+// validateParams ensures each of the addresses is valid and that the height
+// range, when fully specified, is not reversed.
+func (c *GetAddressTxidsCmd) validateParams() error {
+	if err := checkAddressesParam("addresses", c.Addresses); err != nil {
+		return err
+	}
+	return checkHeightRange(c.Start, c.End)
+}
+
+// GetAddressUTXOsCmd defines the getaddressutxos JSON-RPC command.  It
+// requests the unspent outputs paying to the addresses from the address index.
+// When ChainInfo is set, the outputs are returned along with the hash and
+// height of the best block rather than as a plain array.
+type GetAddressUTXOsCmd struct {
+	Addresses []string
+	ChainInfo *bool `jsonrpcdefault:"false"`
+}
+
+// NewGetAddressUTXOsCmd returns a new instance which can be used to issue a
+// getaddressutxos JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewGetAddressUTXOsCmd(addresses []string, chainInfo *bool) *GetAddressUTXOsCmd {
+	return &GetAddressUTXOsCmd{
+		Addresses: addresses,
+		ChainInfo: chainInfo,
+	}
+}
+
+// validateParams ensures each of the addresses is valid.
+func (c *GetAddressUTXOsCmd) validateParams() error {
+	return checkAddressesParam("addresses", c.Addresses)
+}
+
+// GetMempoolAncestorsCmd defines the getmempoolancestors JSON-RPC command.  It
+// returns the in-mempool ancestors of a transaction in the memory pool,
+// either as an array of transaction hashes or, when Verbose is set, as a map of
+// transaction hashes to their mempool entries.
+type GetMempoolAncestorsCmd struct {
+	TxID    string
+	Verbose *bool `jsonrpcdefault:"false"`
+}
+
+// NewGetMempoolAncestorsCmd returns a new instance which can be used to issue a
+// getmempoolancestors JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewGetMempoolAncestorsCmd(txID string, verbose *bool) *GetMempoolAncestorsCmd {
+	return &GetMempoolAncestorsCmd{
+		TxID:    txID,
+		Verbose: verbose,
+	}
+}
+
+// validateParams ensures the transaction id is a valid hash.
+func (c *GetMempoolAncestorsCmd) validateParams() error {
+	return checkHashParam("txid", c.TxID)
+}
+
+// GetMempoolDescendantsCmd defines the getmempooldescendants JSON-RPC command.
+// It returns the in-mempool descendants of a transaction in the memory pool,
+// either as an array of transaction hashes or, when Verbose is set, as a map of
+// transaction hashes to their mempool entries.
+type GetMempoolDescendantsCmd struct {
+	TxID    string
+	Verbose *bool `jsonrpcdefault:"false"`
+}
+
+// NewGetMempoolDescendantsCmd returns a new instance which can be used to issue
+// a getmempooldescendants JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewGetMempoolDescendantsCmd(txID string, verbose *bool) *GetMempoolDescendantsCmd {
+	return &GetMempoolDescendantsCmd{
+		TxID:    txID,
+		Verbose: verbose,
+	}
+}
+
+// validateParams ensures the transaction id is a valid hash.
+func (c *GetMempoolDescendantsCmd) validateParams() error {
+	return checkHashParam("txid", c.TxID)
+}
+
+// GetSpentInfoCmd defines the getspentinfo JSON-RPC command.  It looks up the
+// transaction input which spends the output identified by the transaction hash
+// and output index.
+type GetSpentInfoCmd struct {
+	TxID  string
+	Index uint32
+}
+
+// NewGetSpentInfoCmd returns a new instance which can be used to issue a
+// getspentinfo JSON-RPC command.
+func NewGetSpentInfoCmd(txID string, index uint32) *GetSpentInfoCmd {
+	return &GetSpentInfoCmd{
+		TxID:  txID,
+		Index: index,
+	}
+}
+
+// validateParams ensures the transaction hash is valid.  The index can not be
+// negative since it is unmarshalled as a uint32.
+func (c *GetSpentInfoCmd) validateParams() error {
+	return checkHashParam("txid", c.TxID)
+}
+
+// ListBannedCmd defines the listbanned JSON-RPC command.
+type ListBannedCmd struct{}
+
+// NewListBannedCmd returns a new instance which can be used to issue a
+// listbanned JSON-RPC command.
+func NewListBannedCmd() *ListBannedCmd {
+	return &ListBannedCmd{}
+}
+
 // LoadTxFilterCmd defines the loadtxfilter request parameters to load or
 // reload a transaction filter.
 //
@@ -126,11 +595,26 @@ func NewLoadTxFilterCmd(reload bool, addresses []string, outPoints []OutPoint) *
 	}
 }
 
-// NotifySpentCmd defines the notifyspent JSON-RPC command.
+// validateParams ensures each of the addresses is valid and that each of the
+// outpoints references a valid transaction hash.
+func (c *LoadTxFilterCmd) validateParams() error {
+	if err := checkAddressesParam("addresses", c.Addresses); err != nil {
+		return err
+	}
+	return checkOutPointsParam("outpoints", c.OutPoints)
+}
+
+// NotifySpentCmd defines the notifyspent JSON-RPC command.  CheckHistory
+// requests that a redeemingtx notification is delivered immediately for any
+// outpoint which is already spent by a transaction in the memory pool rather
+// than only watching for future spends.
+// The outpoints are held by value, so a command returned by UnmarshalCmd never
+// refers to outpoints owned by the caller.
 //
 // NOTE: Deprecated. Use LoadTxFilterCmd instead.
 type NotifySpentCmd struct {
-	OutPoints []OutPoint
+	OutPoints    []OutPoint
+	CheckHistory *bool `jsonrpcdefault:"true"`
 }
 
 // NewNotifySpentCmd returns a new instance which can be used to issue a
@@ -143,6 +627,58 @@ func NewNotifySpentCmd(outPoints []OutPoint) *NotifySpentCmd {
 	}
 }
 
+// WithCheckHistory sets whether a redeemingtx notification is requested for
+// outpoints which are already spent and returns the command so calls may be
+// chained.  Since the default is true, it is only included in the marshalled
+// command when false.
+func (c *NotifySpentCmd) WithCheckHistory(checkHistory bool) *NotifySpentCmd {
+	c.CheckHistory = nil
+	if !checkHistory {
+		c.CheckHistory = &checkHistory
+	}
+	return c
+}
+
+// DisconnectNodeCmd defines the disconnectnode JSON-RPC command.  It
+// disconnects the peer identified by the provided host:port address.
+type DisconnectNodeCmd struct {
+	Target string
+}
+
+// NewDisconnectNodeCmd returns a new instance which can be used to issue a
+// disconnectnode JSON-RPC command.
+func NewDisconnectNodeCmd(target string) *DisconnectNodeCmd {
+	return &DisconnectNodeCmd{
+		Target: target,
+	}
+}
+
+// NotifyWalletCmd defines the notifywallet JSON-RPC command.  It registers
+// for both received-to-address and spent-from-outpoint notifications in a
+// single request.
+type NotifyWalletCmd struct {
+	Addresses []string
+	OutPoints []OutPoint
+}
+
+// NewNotifyWalletCmd returns a new instance which can be used to issue a
+// notifywallet JSON-RPC command.
+func NewNotifyWalletCmd(addresses []string, outPoints []OutPoint) *NotifyWalletCmd {
+	return &NotifyWalletCmd{
+		Addresses: addresses,
+		OutPoints: outPoints,
+	}
+}
+
+// validateParams ensures each of the addresses is valid and that each of the
+// outpoints refers to a valid transaction hash.
+func (c *NotifyWalletCmd) validateParams() error {
+	if err := checkAddressesParam("addresses", c.Addresses); err != nil {
+		return err
+	}
+	return checkOutPointsParam("outpoints", c.OutPoints)
+}
+
 // StopNotifyReceivedCmd defines the stopnotifyreceived JSON-RPC command.
 //
 // NOTE: Deprecated. Use LoadTxFilterCmd instead.
@@ -181,28 +717,76 @@ func NewStopNotifySpentCmd(outPoints []OutPoint) *StopNotifySpentCmd {
 //
 // NOTE: Deprecated. Use RescanBlocksCmd instead.
 type RescanCmd struct {
-	BeginBlock string
-	Addresses  []string
-	OutPoints  []OutPoint
-	EndBlock   *string
+	BeginBlock    string
+	Addresses     []string
+	OutPoints     []OutPoint
+	EndBlock      *string `jsonrpcnullable:"true"`
+	TimeoutMillis *int
 }
 
 // NewRescanCmd returns a new instance which can be used to issue a rescan
 // JSON-RPC command.
 //
 // The parameters which are pointers indicate they are optional.  Passing nil
-// for optional parameters will use the default value.
+// for optional parameters will use the default value.  Duplicate addresses and
+// outpoints are removed, keeping the first occurrence of each.
 //
 // NOTE: Deprecated. Use NewRescanBlocksCmd instead.
 func NewRescanCmd(beginBlock string, addresses []string, outPoints []OutPoint, endBlock *string) *RescanCmd {
 	return &RescanCmd{
 		BeginBlock: beginBlock,
-		Addresses:  addresses,
-		OutPoints:  outPoints,
+		Addresses:  uniqueAddresses(addresses),
+		OutPoints:  uniqueOutPoints(outPoints),
 		EndBlock:   endBlock,
 	}
 }
 
+// uniqueAddresses returns the passed addresses with any duplicates removed
+// while preserving the order of their first occurrence.  The passed slice is
+// returned as is when it does not contain any duplicates.
+func uniqueAddresses(addrs []string) []string {
+	seen := make(map[string]struct{}, len(addrs))
+	unique := addrs[:0:0]
+	for _, addr := range addrs {
+		if _, ok := seen[addr]; ok {
+			continue
+		}
+		seen[addr] = struct{}{}
+		unique = append(unique, addr)
+	}
+	if len(unique) == len(addrs) {
+		return addrs
+	}
+	return unique
+}
+
+// uniqueOutPoints returns the passed outpoints with any duplicates removed
+// while preserving the order of their first occurrence.  The passed slice is
+// returned as is when it does not contain any duplicates.
+func uniqueOutPoints(ops []OutPoint) []OutPoint {
+	seen := make(map[OutPoint]struct{}, len(ops))
+	unique := ops[:0:0]
+	for _, op := range ops {
+		if _, ok := seen[op]; ok {
+			continue
+		}
+		seen[op] = struct{}{}
+		unique = append(unique, op)
+	}
+	if len(unique) == len(ops) {
+		return ops
+	}
+	return unique
+}
+
+// WithTimeout sets the server-side timeout, in milliseconds, the client is
+// willing to wait for the rescan to complete and returns the command so calls
+// may be chained.  The timeout is only a hint which servers may ignore.
+func (c *RescanCmd) WithTimeout(ms int) *RescanCmd {
+	c.TimeoutMillis = &ms
+	return c
+}
+
 // RescanBlocksCmd defines the rescan JSON-RPC command.
 //
 // NOTE: This is a btcd extension ported from github.com/decred/dcrd/dcrjson
@@ -221,21 +805,117 @@ func NewRescanBlocksCmd(blockHashes []string) *RescanBlocksCmd {
 	return &RescanBlocksCmd{BlockHashes: blockHashes}
 }
 
+// validateParams ensures each of the block hashes is valid.
+func (c *RescanBlocksCmd) validateParams() error {
+	for i, hash := range c.BlockHashes {
+		err := checkHashParam(fmt.Sprintf("blockhashes[%d]", i), hash)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WaitForBlockHeightCmd defines the waitforblockheight JSON-RPC command.  It
+// blocks until the best chain reaches at least the provided height or the
+// timeout, in milliseconds, elapses.  A timeout of zero waits indefinitely.
+type WaitForBlockHeightCmd struct {
+	Height  int32
+	Timeout *int `jsonrpcdefault:"0"`
+}
+
+// NewWaitForBlockHeightCmd returns a new instance which can be used to issue a
+// waitforblockheight JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewWaitForBlockHeightCmd(height int32, timeout *int) *WaitForBlockHeightCmd {
+	return &WaitForBlockHeightCmd{
+		Height:  height,
+		Timeout: timeout,
+	}
+}
+
+// validateParams ensures neither the height nor the timeout are negative.
+func (c *WaitForBlockHeightCmd) validateParams() error {
+	if c.Height < 0 {
+		str := fmt.Sprintf("parameter 'height' must not be negative "+
+			"(got %d)", c.Height)
+		return makeError(ErrInvalidParameter, str)
+	}
+	return checkTimeoutParam(c.Timeout)
+}
+
+// WaitForNewBlockCmd defines the waitfornewblock JSON-RPC command.  It blocks
+// until a new block is connected to the best chain or the timeout, in
+// milliseconds, elapses.  A timeout of zero waits indefinitely.
+type WaitForNewBlockCmd struct {
+	Timeout *int `jsonrpcdefault:"0"`
+}
+
+// NewWaitForNewBlockCmd returns a new instance which can be used to issue a
+// waitfornewblock JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewWaitForNewBlockCmd(timeout *int) *WaitForNewBlockCmd {
+	return &WaitForNewBlockCmd{
+		Timeout: timeout,
+	}
+}
+
+// validateParams ensures the timeout is not negative.
+func (c *WaitForNewBlockCmd) validateParams() error {
+	return checkTimeoutParam(c.Timeout)
+}
+
+// checkTimeoutParam returns an error when the passed optional timeout, in
+// milliseconds, is negative.
+func checkTimeoutParam(timeout *int) error {
+	if timeout != nil && *timeout < 0 {
+		str := fmt.Sprintf("parameter 'timeout' must not be negative "+
+			"(got %d)", *timeout)
+		return makeError(ErrInvalidParameter, str)
+	}
+	return nil
+}
+
 func init() {
 	// The commands in this file are only usable by websockets.
 	flags := UFWebsocketOnly
 
 	MustRegisterCmd("authenticate", (*AuthenticateCmd)(nil), flags)
+	MustRegisterCmd("clearbanned", (*ClearBannedCmd)(nil), flags)
+	MustRegisterCmd("clearnotifications", (*ClearNotificationsCmd)(nil), flags)
+	MustRegisterCmd("combinerawtransaction", (*CombineRawTransactionCmd)(nil), flags)
+	MustRegisterCmd("disconnectnode", (*DisconnectNodeCmd)(nil), flags)
+	MustRegisterCmd("filteradd", (*FilterAddCmd)(nil), flags)
+	MustRegisterCmd("filterclear", (*FilterClearCmd)(nil), flags)
+	MustRegisterCmd("filterload", (*FilterLoadCmd)(nil), flags)
+	MustRegisterCmd("getaddressbalance", (*GetAddressBalanceCmd)(nil), flags)
+	MustRegisterCmd("getaddressdeltas", (*GetAddressDeltasCmd)(nil), flags)
+	MustRegisterCmd("getaddressmempool", (*GetAddressMempoolCmd)(nil), flags)
+	MustRegisterCmd("getaddresstxids", (*GetAddressTxidsCmd)(nil), flags)
+	MustRegisterCmd("getaddressutxos", (*GetAddressUTXOsCmd)(nil), flags)
+	MustRegisterCmd("getmempoolancestors", (*GetMempoolAncestorsCmd)(nil), flags)
+	MustRegisterCmd("getmempooldescendants", (*GetMempoolDescendantsCmd)(nil), flags)
+	MustRegisterCmd("getspentinfo", (*GetSpentInfoCmd)(nil), flags)
+	MustRegisterCmd("listbanned", (*ListBannedCmd)(nil), flags)
 	MustRegisterCmd("loadtxfilter", (*LoadTxFilterCmd)(nil), flags)
 	MustRegisterCmd("notifyblocks", (*NotifyBlocksCmd)(nil), flags)
 	MustRegisterCmd("notifynewtransactions", (*NotifyNewTransactionsCmd)(nil), flags)
+	MustRegisterCmd("notifynewtxsverbose", (*NotifyNewTXsVerboseCmd)(nil), flags)
 	MustRegisterCmd("notifyreceived", (*NotifyReceivedCmd)(nil), flags)
 	MustRegisterCmd("notifyspent", (*NotifySpentCmd)(nil), flags)
+	MustRegisterCmd("notifywallet", (*NotifyWalletCmd)(nil), flags)
 	MustRegisterCmd("session", (*SessionCmd)(nil), flags)
+	MustRegisterCmd("setban", (*SetBanCmd)(nil), flags)
 	MustRegisterCmd("stopnotifyblocks", (*StopNotifyBlocksCmd)(nil), flags)
 	MustRegisterCmd("stopnotifynewtransactions", (*StopNotifyNewTransactionsCmd)(nil), flags)
 	MustRegisterCmd("stopnotifyspent", (*StopNotifySpentCmd)(nil), flags)
 	MustRegisterCmd("stopnotifyreceived", (*StopNotifyReceivedCmd)(nil), flags)
 	MustRegisterCmd("rescan", (*RescanCmd)(nil), flags)
 	MustRegisterCmd("rescanblocks", (*RescanBlocksCmd)(nil), flags)
+	MustRegisterCmd("waitforblockheight", (*WaitForBlockHeightCmd)(nil), flags)
+	MustRegisterCmd("waitfornewblock", (*WaitForNewBlockCmd)(nil), flags)
 }
@@ -10,11 +10,19 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/btcsuite/btcd/btcjson"
 )
 
+// combineTx1 and combineTx2 are two partially signed versions of the same
+// transaction used to test the combinerawtransaction command.
+const (
+	combineTx1 = "010000000111111111111111111111111111111111111111111111111111111111111111110000000000ffffffff01e803000000000000015100000000"
+	combineTx2 = "01000000011111111111111111111111111111111111111111111111111111111111111111000000000151ffffffff01e803000000000000015100000000"
+)
+
 // TestChainSvrWsCmds tests all of the chain server websocket-specific commands
 // marshal and unmarshal into valid results include handling of optional fields
 // being omitted in the marshalled command, while optional fields with defaults
@@ -41,6 +49,54 @@ func TestChainSvrWsCmds(t *testing.T) {
 			marshalled:   `{"jsonrpc":"1.0","method":"authenticate","params":["user","pass"],"id":1}`,
 			unmarshalled: &btcjson.AuthenticateCmd{Username: "user", Passphrase: "pass"},
 		},
+		{
+			name: "clearbanned",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("clearbanned")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewClearBannedCmd()
+			},
+			marshalled:   `{"jsonrpc":"1.0","method":"clearbanned","params":[],"id":1}`,
+			unmarshalled: &btcjson.ClearBannedCmd{},
+		},
+		{
+			name: "clearnotifications",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("clearnotifications")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewClearNotificationsCmd()
+			},
+			marshalled:   `{"jsonrpc":"1.0","method":"clearnotifications","params":[],"id":1}`,
+			unmarshalled: &btcjson.ClearNotificationsCmd{},
+		},
+		{
+			name: "combinerawtransaction",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("combinerawtransaction", []string{combineTx1, combineTx2})
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewCombineRawTransactionCmd([]string{combineTx1, combineTx2})
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"combinerawtransaction","params":[["` + combineTx1 + `","` + combineTx2 + `"]],"id":1}`,
+			unmarshalled: &btcjson.CombineRawTransactionCmd{
+				Txs: []string{combineTx1, combineTx2},
+			},
+		},
+		{
+			name: "disconnectnode",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("disconnectnode", "127.0.0.1:8333")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewDisconnectNodeCmd("127.0.0.1:8333")
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"disconnectnode","params":["127.0.0.1:8333"],"id":1}`,
+			unmarshalled: &btcjson.DisconnectNodeCmd{
+				Target: "127.0.0.1:8333",
+			},
+		},
 		{
 			name: "notifyblocks",
 			newCmd: func() (interface{}, error) {
@@ -89,6 +145,20 @@ func TestChainSvrWsCmds(t *testing.T) {
 				Verbose: btcjson.Bool(true),
 			},
 		},
+		{
+			name: "notifynewtxsverbose",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("notifynewtxsverbose", []string{"1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"})
+			},
+			staticCmd: func() interface{} {
+				addrs := []string{"1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"}
+				return btcjson.NewNotifyNewTXsVerboseCmd(addrs)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"notifynewtxsverbose","params":[["1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"]],"id":1}`,
+			unmarshalled: &btcjson.NotifyNewTXsVerboseCmd{
+				Addresses: []string{"1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"},
+			},
+		},
 		{
 			name: "stopnotifynewtransactions",
 			newCmd: func() (interface{}, error) {
@@ -137,9 +207,103 @@ func TestChainSvrWsCmds(t *testing.T) {
 			},
 			marshalled: `{"jsonrpc":"1.0","method":"notifyspent","params":[[{"hash":"123","index":0}]],"id":1}`,
 			unmarshalled: &btcjson.NotifySpentCmd{
+				OutPoints:    []btcjson.OutPoint{{Hash: "123", Index: 0}},
+				CheckHistory: btcjson.Bool(true),
+			},
+		},
+		{
+			name: "notifyspent check history",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("notifyspent", `[{"hash":"123","index":0}]`)
+			},
+			staticCmd: func() interface{} {
+				ops := []btcjson.OutPoint{{Hash: "123", Index: 0}}
+				return btcjson.NewNotifySpentCmd(ops).WithCheckHistory(true)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"notifyspent","params":[[{"hash":"123","index":0}]],"id":1}`,
+			unmarshalled: &btcjson.NotifySpentCmd{
+				OutPoints:    []btcjson.OutPoint{{Hash: "123", Index: 0}},
+				CheckHistory: btcjson.Bool(true),
+			},
+		},
+		{
+			name: "notifyspent without history",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("notifyspent", `[{"hash":"123","index":0}]`, false)
+			},
+			staticCmd: func() interface{} {
+				ops := []btcjson.OutPoint{{Hash: "123", Index: 0}}
+				return btcjson.NewNotifySpentCmd(ops).WithCheckHistory(false)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"notifyspent","params":[[{"hash":"123","index":0}],false],"id":1}`,
+			unmarshalled: &btcjson.NotifySpentCmd{
+				OutPoints:    []btcjson.OutPoint{{Hash: "123", Index: 0}},
+				CheckHistory: btcjson.Bool(false),
+			},
+		},
+		{
+			name: "notifywallet",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("notifywallet", []string{"1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"}, `[{"hash":"123","index":0}]`)
+			},
+			staticCmd: func() interface{} {
+				addrs := []string{"1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"}
+				ops := []btcjson.OutPoint{{Hash: "123", Index: 0}}
+				return btcjson.NewNotifyWalletCmd(addrs, ops)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"notifywallet","params":[["1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"],[{"hash":"123","index":0}]],"id":1}`,
+			unmarshalled: &btcjson.NotifyWalletCmd{
+				Addresses: []string{"1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"},
 				OutPoints: []btcjson.OutPoint{{Hash: "123", Index: 0}},
 			},
 		},
+		{
+			name: "notifywallet empty",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("notifywallet", []string{}, []btcjson.OutPoint{})
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewNotifyWalletCmd([]string{}, []btcjson.OutPoint{})
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"notifywallet","params":[[],[]],"id":1}`,
+			unmarshalled: &btcjson.NotifyWalletCmd{
+				Addresses: []string{},
+				OutPoints: []btcjson.OutPoint{},
+			},
+		},
+		{
+			name: "setban",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("setban", "192.168.0.0/24", "add")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewSetBanCmd("192.168.0.0/24", btcjson.SBAdd, nil, nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"setban","params":["192.168.0.0/24","add"],"id":1}`,
+			unmarshalled: &btcjson.SetBanCmd{
+				Subnet:   "192.168.0.0/24",
+				SubCmd:   btcjson.SBAdd,
+				BanTime:  btcjson.Uint32(0),
+				Absolute: btcjson.Bool(false),
+			},
+		},
+		{
+			name: "setban optional",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("setban", "192.168.0.1", "remove", 86400, true)
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewSetBanCmd("192.168.0.1", btcjson.SBRemove,
+					btcjson.Uint32(86400), btcjson.Bool(true))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"setban","params":["192.168.0.1","remove",86400,true],"id":1}`,
+			unmarshalled: &btcjson.SetBanCmd{
+				Subnet:   "192.168.0.1",
+				SubCmd:   btcjson.SBRemove,
+				BanTime:  btcjson.Uint32(86400),
+				Absolute: btcjson.Bool(true),
+			},
+		},
 		{
 			name: "stopnotifyspent",
 			newCmd: func() (interface{}, error) {
@@ -194,25 +358,329 @@ func TestChainSvrWsCmds(t *testing.T) {
 			},
 		},
 		{
-			name: "loadtxfilter",
+			name: "rescan timeout",
 			newCmd: func() (interface{}, error) {
-				return btcjson.NewCmd("loadtxfilter", false, `["1Address"]`, `[{"hash":"0000000000000000000000000000000000000000000000000000000000000123","index":0}]`)
+				return btcjson.NewCmd("rescan", "123", `["1Address"]`, `[{"hash":"123","index":0}]`, nil, 60000)
 			},
 			staticCmd: func() interface{} {
 				addrs := []string{"1Address"}
+				ops := []btcjson.OutPoint{{Hash: "123", Index: 0}}
+				return btcjson.NewRescanCmd("123", addrs, ops, nil).WithTimeout(60000)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"rescan","params":["123",["1Address"],[{"hash":"123","index":0}],null,60000],"id":1}`,
+			unmarshalled: &btcjson.RescanCmd{
+				BeginBlock:    "123",
+				Addresses:     []string{"1Address"},
+				OutPoints:     []btcjson.OutPoint{{Hash: "123", Index: 0}},
+				EndBlock:      nil,
+				TimeoutMillis: btcjson.Int(60000),
+			},
+		},
+		{
+			name: "filteradd",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("filteradd", "00ff")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewFilterAddCmd("00ff")
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"filteradd","params":["00ff"],"id":1}`,
+			unmarshalled: &btcjson.FilterAddCmd{
+				Data: "00ff",
+			},
+		},
+		{
+			name: "filterclear",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("filterclear")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewFilterClearCmd()
+			},
+			marshalled:   `{"jsonrpc":"1.0","method":"filterclear","params":[],"id":1}`,
+			unmarshalled: &btcjson.FilterClearCmd{},
+		},
+		{
+			name: "filterload",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("filterload", "00ff", 10, 12345, 1)
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewFilterLoadCmd("00ff", 10, 12345, 1)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"filterload","params":["00ff",10,12345,1],"id":1}`,
+			unmarshalled: &btcjson.FilterLoadCmd{
+				Filter:    "00ff",
+				HashFuncs: 10,
+				Tweak:     12345,
+				Flags:     1,
+			},
+		},
+		{
+			name: "getaddressbalance",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("getaddressbalance", []string{"1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"})
+			},
+			staticCmd: func() interface{} {
+				addrs := []string{"1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"}
+				return btcjson.NewGetAddressBalanceCmd(addrs)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getaddressbalance","params":[["1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"]],"id":1}`,
+			unmarshalled: &btcjson.GetAddressBalanceCmd{
+				Addresses: []string{"1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"},
+			},
+		},
+		{
+			name: "getaddressbalance multiple addresses",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("getaddressbalance", []string{"1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2", "3J98t1WpEZ73CNmQviecrnyiWrnqRhWNLy"})
+			},
+			staticCmd: func() interface{} {
+				addrs := []string{"1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2", "3J98t1WpEZ73CNmQviecrnyiWrnqRhWNLy"}
+				return btcjson.NewGetAddressBalanceCmd(addrs)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getaddressbalance","params":[["1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2","3J98t1WpEZ73CNmQviecrnyiWrnqRhWNLy"]],"id":1}`,
+			unmarshalled: &btcjson.GetAddressBalanceCmd{
+				Addresses: []string{"1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2", "3J98t1WpEZ73CNmQviecrnyiWrnqRhWNLy"},
+			},
+		},
+		{
+			name: "getaddressdeltas",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("getaddressdeltas", []string{"1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"})
+			},
+			staticCmd: func() interface{} {
+				addrs := []string{"1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"}
+				return btcjson.NewGetAddressDeltasCmd(addrs, nil, nil, nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getaddressdeltas","params":[["1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"]],"id":1}`,
+			unmarshalled: &btcjson.GetAddressDeltasCmd{
+				Addresses: []string{"1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"},
+			},
+		},
+		{
+			name: "getaddressdeltas optional",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("getaddressdeltas", []string{"1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"}, 100, 200, true)
+			},
+			staticCmd: func() interface{} {
+				addrs := []string{"1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"}
+				return btcjson.NewGetAddressDeltasCmd(addrs,
+					btcjson.Int32(100), btcjson.Int32(200),
+					btcjson.Bool(true))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getaddressdeltas","params":[["1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"],100,200,true],"id":1}`,
+			unmarshalled: &btcjson.GetAddressDeltasCmd{
+				Addresses: []string{"1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"},
+				Start:     btcjson.Int32(100),
+				End:       btcjson.Int32(200),
+				ChainInfo: btcjson.Bool(true),
+			},
+		},
+		{
+			name: "getaddressmempool",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("getaddressmempool", []string{"1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"})
+			},
+			staticCmd: func() interface{} {
+				addrs := []string{"1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"}
+				return btcjson.NewGetAddressMempoolCmd(addrs)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getaddressmempool","params":[["1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"]],"id":1}`,
+			unmarshalled: &btcjson.GetAddressMempoolCmd{
+				Addresses: []string{"1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"},
+			},
+		},
+		{
+			name: "getaddresstxids",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("getaddresstxids", []string{"1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"})
+			},
+			staticCmd: func() interface{} {
+				addrs := []string{"1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"}
+				return btcjson.NewGetAddressTxidsCmd(addrs, nil, nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getaddresstxids","params":[["1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"]],"id":1}`,
+			unmarshalled: &btcjson.GetAddressTxidsCmd{
+				Addresses: []string{"1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"},
+			},
+		},
+		{
+			name: "getaddresstxids optional",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("getaddresstxids", []string{"1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"}, 100, 200)
+			},
+			staticCmd: func() interface{} {
+				addrs := []string{"1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"}
+				return btcjson.NewGetAddressTxidsCmd(addrs,
+					btcjson.Int32(100), btcjson.Int32(200))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getaddresstxids","params":[["1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"],100,200],"id":1}`,
+			unmarshalled: &btcjson.GetAddressTxidsCmd{
+				Addresses: []string{"1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"},
+				Start:     btcjson.Int32(100),
+				End:       btcjson.Int32(200),
+			},
+		},
+		{
+			name: "getaddressutxos",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("getaddressutxos", []string{"1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"})
+			},
+			staticCmd: func() interface{} {
+				addrs := []string{"1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"}
+				return btcjson.NewGetAddressUTXOsCmd(addrs, nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getaddressutxos","params":[["1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"]],"id":1}`,
+			unmarshalled: &btcjson.GetAddressUTXOsCmd{
+				Addresses: []string{"1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"},
+				ChainInfo: btcjson.Bool(false),
+			},
+		},
+		{
+			name: "getaddressutxos optional",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("getaddressutxos", []string{"1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"}, true)
+			},
+			staticCmd: func() interface{} {
+				addrs := []string{"1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"}
+				return btcjson.NewGetAddressUTXOsCmd(addrs, btcjson.Bool(true))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getaddressutxos","params":[["1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"],true],"id":1}`,
+			unmarshalled: &btcjson.GetAddressUTXOsCmd{
+				Addresses: []string{"1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"},
+				ChainInfo: btcjson.Bool(true),
+			},
+		},
+		{
+			name: "getmempoolancestors",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("getmempoolancestors", "0000000000000000000000000000000000000000000000000000000000000123")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewGetMempoolAncestorsCmd("0000000000000000000000000000000000000000000000000000000000000123", nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getmempoolancestors","params":["0000000000000000000000000000000000000000000000000000000000000123"],"id":1}`,
+			unmarshalled: &btcjson.GetMempoolAncestorsCmd{
+				TxID:    "0000000000000000000000000000000000000000000000000000000000000123",
+				Verbose: btcjson.Bool(false),
+			},
+		},
+		{
+			name: "getmempoolancestors verbose",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("getmempoolancestors", "0000000000000000000000000000000000000000000000000000000000000123", true)
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewGetMempoolAncestorsCmd("0000000000000000000000000000000000000000000000000000000000000123",
+					btcjson.Bool(true))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getmempoolancestors","params":["0000000000000000000000000000000000000000000000000000000000000123",true],"id":1}`,
+			unmarshalled: &btcjson.GetMempoolAncestorsCmd{
+				TxID:    "0000000000000000000000000000000000000000000000000000000000000123",
+				Verbose: btcjson.Bool(true),
+			},
+		},
+		{
+			name: "getmempooldescendants",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("getmempooldescendants", "0000000000000000000000000000000000000000000000000000000000000123")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewGetMempoolDescendantsCmd("0000000000000000000000000000000000000000000000000000000000000123", nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getmempooldescendants","params":["0000000000000000000000000000000000000000000000000000000000000123"],"id":1}`,
+			unmarshalled: &btcjson.GetMempoolDescendantsCmd{
+				TxID:    "0000000000000000000000000000000000000000000000000000000000000123",
+				Verbose: btcjson.Bool(false),
+			},
+		},
+		{
+			name: "getmempooldescendants verbose",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("getmempooldescendants", "0000000000000000000000000000000000000000000000000000000000000123", true)
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewGetMempoolDescendantsCmd("0000000000000000000000000000000000000000000000000000000000000123",
+					btcjson.Bool(true))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getmempooldescendants","params":["0000000000000000000000000000000000000000000000000000000000000123",true],"id":1}`,
+			unmarshalled: &btcjson.GetMempoolDescendantsCmd{
+				TxID:    "0000000000000000000000000000000000000000000000000000000000000123",
+				Verbose: btcjson.Bool(true),
+			},
+		},
+		{
+			name: "getspentinfo",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("getspentinfo", "123", 1)
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewGetSpentInfoCmd("123", 1)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getspentinfo","params":["123",1],"id":1}`,
+			unmarshalled: &btcjson.GetSpentInfoCmd{
+				TxID:  "123",
+				Index: 1,
+			},
+		},
+		{
+			name: "listbanned",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("listbanned")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewListBannedCmd()
+			},
+			marshalled:   `{"jsonrpc":"1.0","method":"listbanned","params":[],"id":1}`,
+			unmarshalled: &btcjson.ListBannedCmd{},
+		},
+		{
+			name: "loadtxfilter",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("loadtxfilter", false, `["1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"]`, `[{"hash":"0000000000000000000000000000000000000000000000000000000000000123","index":0}]`)
+			},
+			staticCmd: func() interface{} {
+				addrs := []string{"1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"}
 				ops := []btcjson.OutPoint{{
 					Hash:  "0000000000000000000000000000000000000000000000000000000000000123",
 					Index: 0,
 				}}
 				return btcjson.NewLoadTxFilterCmd(false, addrs, ops)
 			},
-			marshalled: `{"jsonrpc":"1.0","method":"loadtxfilter","params":[false,["1Address"],[{"hash":"0000000000000000000000000000000000000000000000000000000000000123","index":0}]],"id":1}`,
+			marshalled: `{"jsonrpc":"1.0","method":"loadtxfilter","params":[false,["1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"],[{"hash":"0000000000000000000000000000000000000000000000000000000000000123","index":0}]],"id":1}`,
 			unmarshalled: &btcjson.LoadTxFilterCmd{
 				Reload:    false,
-				Addresses: []string{"1Address"},
+				Addresses: []string{"1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"},
 				OutPoints: []btcjson.OutPoint{{Hash: "0000000000000000000000000000000000000000000000000000000000000123", Index: 0}},
 			},
 		},
+		{
+			name: "loadtxfilter reload",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("loadtxfilter", true, `["1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2","3J98t1WpEZ73CNmQviecrnyiWrnqRhWNLy"]`, `[{"hash":"0000000000000000000000000000000000000000000000000000000000000123","index":0},{"hash":"0000000000000000000000000000000000000000000000000000000000000456","index":3}]`)
+			},
+			staticCmd: func() interface{} {
+				addrs := []string{"1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2", "3J98t1WpEZ73CNmQviecrnyiWrnqRhWNLy"}
+				ops := []btcjson.OutPoint{{
+					Hash:  "0000000000000000000000000000000000000000000000000000000000000123",
+					Index: 0,
+				}, {
+					Hash:  "0000000000000000000000000000000000000000000000000000000000000456",
+					Index: 3,
+				}}
+				return btcjson.NewLoadTxFilterCmd(true, addrs, ops)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"loadtxfilter","params":[true,["1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2","3J98t1WpEZ73CNmQviecrnyiWrnqRhWNLy"],[{"hash":"0000000000000000000000000000000000000000000000000000000000000123","index":0},{"hash":"0000000000000000000000000000000000000000000000000000000000000456","index":3}]],"id":1}`,
+			unmarshalled: &btcjson.LoadTxFilterCmd{
+				Reload:    true,
+				Addresses: []string{"1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2", "3J98t1WpEZ73CNmQviecrnyiWrnqRhWNLy"},
+				OutPoints: []btcjson.OutPoint{
+					{Hash: "0000000000000000000000000000000000000000000000000000000000000123", Index: 0},
+					{Hash: "0000000000000000000000000000000000000000000000000000000000000456", Index: 3},
+				},
+			},
+		},
 		{
 			name: "rescanblocks",
 			newCmd: func() (interface{}, error) {
@@ -227,6 +695,60 @@ func TestChainSvrWsCmds(t *testing.T) {
 				BlockHashes: []string{"0000000000000000000000000000000000000000000000000000000000000123"},
 			},
 		},
+		{
+			name: "waitforblockheight",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("waitforblockheight", 100)
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewWaitForBlockHeightCmd(100, nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"waitforblockheight","params":[100],"id":1}`,
+			unmarshalled: &btcjson.WaitForBlockHeightCmd{
+				Height:  100,
+				Timeout: btcjson.Int(0),
+			},
+		},
+		{
+			name: "waitforblockheight optional",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("waitforblockheight", 100, 1000)
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewWaitForBlockHeightCmd(100, btcjson.Int(1000))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"waitforblockheight","params":[100,1000],"id":1}`,
+			unmarshalled: &btcjson.WaitForBlockHeightCmd{
+				Height:  100,
+				Timeout: btcjson.Int(1000),
+			},
+		},
+		{
+			name: "waitfornewblock",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("waitfornewblock")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewWaitForNewBlockCmd(nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"waitfornewblock","params":[],"id":1}`,
+			unmarshalled: &btcjson.WaitForNewBlockCmd{
+				Timeout: btcjson.Int(0),
+			},
+		},
+		{
+			name: "waitfornewblock optional",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("waitfornewblock", 1000)
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewWaitForNewBlockCmd(btcjson.Int(1000))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"waitfornewblock","params":[1000],"id":1}`,
+			unmarshalled: &btcjson.WaitForNewBlockCmd{
+				Timeout: btcjson.Int(1000),
+			},
+		},
 	}
 
 	t.Logf("Running %d tests", len(tests))
@@ -247,6 +769,13 @@ func TestChainSvrWsCmds(t *testing.T) {
 			continue
 		}
 
+		// Ensure the params reported for the command match the
+		// marshalled params.
+		if err := checkCmdParams(test.staticCmd(), marshalled); err != nil {
+			t.Errorf("Test #%d (%s) %v", i, test.name, err)
+			continue
+		}
+
 		// Ensure the command is created without error via the generic
 		// new command creation function.
 		cmd, err := test.newCmd()
@@ -295,3 +824,531 @@ func TestChainSvrWsCmds(t *testing.T) {
 		}
 	}
 }
+
+// TestAuthenticateCmdString ensures the string representation of the
+// authenticate command never includes the passphrase.
+func TestAuthenticateCmdString(t *testing.T) {
+	t.Parallel()
+
+	cmd := btcjson.NewAuthenticateCmd("user", "secret")
+	for _, str := range []string{cmd.String(), fmt.Sprintf("%v", cmd)} {
+		if strings.Contains(str, "secret") {
+			t.Fatalf("string representation %q leaks the passphrase",
+				str)
+		}
+		if !strings.Contains(str, "user") {
+			t.Fatalf("string representation %q is missing the "+
+				"username", str)
+		}
+	}
+}
+
+// TestNewRescanCmdDuplicates ensures NewRescanCmd removes duplicate addresses
+// and outpoints while preserving the order of their first occurrence.
+func TestNewRescanCmdDuplicates(t *testing.T) {
+	t.Parallel()
+
+	op1 := btcjson.OutPoint{Hash: "123", Index: 0}
+	op2 := btcjson.OutPoint{Hash: "123", Index: 1}
+	op3 := btcjson.OutPoint{Hash: "456", Index: 0}
+
+	tests := []struct {
+		name      string
+		addrs     []string
+		ops       []btcjson.OutPoint
+		wantAddrs []string
+		wantOps   []btcjson.OutPoint
+	}{
+		{
+			name: "nil",
+		},
+		{
+			name:      "empty",
+			addrs:     []string{},
+			ops:       []btcjson.OutPoint{},
+			wantAddrs: []string{},
+			wantOps:   []btcjson.OutPoint{},
+		},
+		{
+			name:      "no duplicates",
+			addrs:     []string{"1Address", "2Address"},
+			ops:       []btcjson.OutPoint{op1, op2, op3},
+			wantAddrs: []string{"1Address", "2Address"},
+			wantOps:   []btcjson.OutPoint{op1, op2, op3},
+		},
+		{
+			name: "duplicates",
+			addrs: []string{"2Address", "1Address", "2Address",
+				"2Address", "3Address", "1Address"},
+			ops:       []btcjson.OutPoint{op2, op1, op2, op3, op1},
+			wantAddrs: []string{"2Address", "1Address", "3Address"},
+			wantOps:   []btcjson.OutPoint{op2, op1, op3},
+		},
+	}
+
+	t.Logf("Running %d tests", len(tests))
+	for i, test := range tests {
+		cmd := btcjson.NewRescanCmd("123", test.addrs, test.ops, nil)
+		if !reflect.DeepEqual(cmd.Addresses, test.wantAddrs) {
+			t.Errorf("Test #%d (%s) unexpected addresses - got %v, "+
+				"want %v", i, test.name, cmd.Addresses,
+				test.wantAddrs)
+		}
+		if !reflect.DeepEqual(cmd.OutPoints, test.wantOps) {
+			t.Errorf("Test #%d (%s) unexpected outpoints - got %v, "+
+				"want %v", i, test.name, cmd.OutPoints,
+				test.wantOps)
+		}
+	}
+}
+
+// TestChainSvrWsCmdParamErrors ensures UnmarshalCmd rejects chain server
+// websocket commands whose parameters do not satisfy the requirements of the
+// command.
+func TestChainSvrWsCmdParamErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []unmarshalCmdErrorTest{
+		{
+			name: "params for clearnotifications",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "clearnotifications",
+				Params:  []json.RawMessage{[]byte(`true`)},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrTooManyParams},
+		},
+		{
+			name: "missing target for disconnectnode",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "disconnectnode",
+				Params:  []json.RawMessage{},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrTooFewParams},
+		},
+		{
+			name: "too many params for disconnectnode",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "disconnectnode",
+				Params: []json.RawMessage{[]byte(`"127.0.0.1:8333"`),
+					[]byte(`"extra"`)},
+				ID: nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrTooManyParams},
+		},
+		{
+			name: "invalid sub command for setban",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "setban",
+				Params: []json.RawMessage{[]byte(`"192.168.0.1"`),
+					[]byte(`"ban"`)},
+				ID: nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidType},
+		},
+		{
+			name: "negative bantime for setban",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "setban",
+				Params: []json.RawMessage{[]byte(`"192.168.0.1"`),
+					[]byte(`"add"`), []byte(`-1`)},
+				ID: nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidType},
+		},
+		{
+			name: "params for clearbanned",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "clearbanned",
+				Params:  []json.RawMessage{[]byte(`"192.168.0.1"`)},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrTooManyParams},
+		},
+		{
+			name: "invalid txid for getspentinfo",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "getspentinfo",
+				Params: []json.RawMessage{[]byte(`"nothex"`),
+					[]byte(`1`)},
+				ID: nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name: "negative index for getspentinfo",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "getspentinfo",
+				Params: []json.RawMessage{[]byte(`"123"`),
+					[]byte(`-1`)},
+				ID: nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidType},
+		},
+		{
+			name: "out of range index for getspentinfo",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "getspentinfo",
+				Params: []json.RawMessage{[]byte(`"123"`),
+					[]byte(`4294967296`)},
+				ID: nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidType},
+		},
+		{
+			name: "invalid address for getaddressbalance",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "getaddressbalance",
+				Params: []json.RawMessage{
+					[]byte(`["1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2","1Address"]`),
+				},
+				ID: nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name: "invalid address for getaddressutxos",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "getaddressutxos",
+				Params: []json.RawMessage{[]byte(`["1Address"]`),
+					[]byte(`true`)},
+				ID: nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name: "invalid address for getaddresstxids",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "getaddresstxids",
+				Params:  []json.RawMessage{[]byte(`["1Address"]`)},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name: "reversed range for getaddresstxids",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "getaddresstxids",
+				Params: []json.RawMessage{
+					[]byte(`["1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"]`),
+					[]byte(`200`), []byte(`100`)},
+				ID: nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name: "invalid address for getaddressdeltas",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "getaddressdeltas",
+				Params:  []json.RawMessage{[]byte(`["1Address"]`)},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name: "reversed range for getaddressdeltas",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "getaddressdeltas",
+				Params: []json.RawMessage{
+					[]byte(`["1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"]`),
+					[]byte(`200`), []byte(`100`), []byte(`true`)},
+				ID: nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name: "invalid address for getaddressmempool",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "getaddressmempool",
+				Params:  []json.RawMessage{[]byte(`["1Address"]`)},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name: "negative timeout for waitfornewblock",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "waitfornewblock",
+				Params:  []json.RawMessage{[]byte(`-1`)},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name: "negative height for waitforblockheight",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "waitforblockheight",
+				Params:  []json.RawMessage{[]byte(`-1`)},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name: "negative timeout for waitforblockheight",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "waitforblockheight",
+				Params:  []json.RawMessage{[]byte(`100`), []byte(`-1`)},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name: "params for filterclear",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "filterclear",
+				Params:  []json.RawMessage{[]byte(`"00ff"`)},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrTooManyParams},
+		},
+		{
+			name: "malformed hex for filteradd",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "filteradd",
+				Params:  []json.RawMessage{[]byte(`"0g"`)},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name: "oversized data for filteradd",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "filteradd",
+				Params: []json.RawMessage{
+					[]byte(`"` + strings.Repeat("00", 521) + `"`)},
+				ID: nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name: "invalid hex filter for filterload",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "filterload",
+				Params:  []json.RawMessage{[]byte(`"zz"`), []byte(`10`), []byte(`0`), []byte(`0`)},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name: "oversized filter for filterload",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "filterload",
+				Params: []json.RawMessage{
+					[]byte(`"` + strings.Repeat("00", 36001) + `"`),
+					[]byte(`10`), []byte(`0`), []byte(`0`)},
+				ID: nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name: "too many hash funcs for filterload",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "filterload",
+				Params:  []json.RawMessage{[]byte(`"00"`), []byte(`51`), []byte(`0`), []byte(`0`)},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name: "unknown flags for filterload",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "filterload",
+				Params:  []json.RawMessage{[]byte(`"00"`), []byte(`10`), []byte(`0`), []byte(`3`)},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name: "out of range flags for filterload",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "filterload",
+				Params:  []json.RawMessage{[]byte(`"00"`), []byte(`10`), []byte(`0`), []byte(`256`)},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidType},
+		},
+		{
+			name: "too few params for authenticate",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "authenticate",
+				Params:  []json.RawMessage{[]byte(`"user"`)},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrTooFewParams},
+		},
+		{
+			name: "non-string passphrase for authenticate",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "authenticate",
+				Params:  []json.RawMessage{[]byte(`"user"`), []byte(`1`)},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidType},
+		},
+		{
+			name: "invalid address for notifynewtxsverbose",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "notifynewtxsverbose",
+				Params:  []json.RawMessage{[]byte(`["1Address"]`)},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name: "no transactions for combinerawtransaction",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "combinerawtransaction",
+				Params:  []json.RawMessage{[]byte(`[]`)},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name: "invalid hex for combinerawtransaction",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "combinerawtransaction",
+				Params:  []json.RawMessage{[]byte(`["010000000111111111111111111111111111111111111111111111111111111111111111110000000000ffffffff01e803000000000000015100000000","xyz"]`)},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name: "invalid transaction for combinerawtransaction",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "combinerawtransaction",
+				Params:  []json.RawMessage{[]byte(`["010000000111111111111111111111111111111111111111111111111111111111111111110000000000ffffffff01e803000000000000015100000000","0100"]`)},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name: "malformed block hash for rescanblocks",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "rescanblocks",
+				Params:  []json.RawMessage{[]byte(`["123","xyz"]`)},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name: "invalid address for loadtxfilter",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "loadtxfilter",
+				Params: []json.RawMessage{[]byte(`true`), []byte(`["1Address"]`),
+					[]byte(`[]`)},
+				ID: nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name: "malformed outpoint hash for loadtxfilter",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "loadtxfilter",
+				Params: []json.RawMessage{[]byte(`false`), []byte(`[]`),
+					[]byte(`[{"hash":"xyz","index":0}]`)},
+				ID: nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name: "invalid address for notifywallet",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "notifywallet",
+				Params: []json.RawMessage{[]byte(`["1Address"]`),
+					[]byte(`[]`)},
+				ID: nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name: "malformed outpoint hash for notifywallet",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "notifywallet",
+				Params: []json.RawMessage{[]byte(`[]`),
+					[]byte(`[{"hash":"xyz","index":0}]`)},
+				ID: nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name: "malformed txid for getmempoolancestors",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "getmempoolancestors",
+				Params:  []json.RawMessage{[]byte(`"xyz"`)},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name: "invalid verbose type for getmempoolancestors",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "getmempoolancestors",
+				Params:  []json.RawMessage{[]byte(`"123"`), []byte(`"true"`)},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidType},
+		},
+		{
+			name: "malformed txid for getmempooldescendants",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "getmempooldescendants",
+				Params:  []json.RawMessage{[]byte(`"xyz"`)},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name: "invalid verbose type for getmempooldescendants",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "getmempooldescendants",
+				Params:  []json.RawMessage{[]byte(`"123"`), []byte(`"true"`)},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidType},
+		},
+	}
+	testUnmarshalCmdErrors(t, tests)
+}
@@ -8,6 +8,16 @@
 
 package btcjson
 
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/btcsuite/btcd/wire"
+)
+
 const (
 	// BlockConnectedNtfnMethod is the legacy, deprecated method used for
 	// notifications from the chain server that a block has been connected.
@@ -22,6 +32,11 @@ const (
 	// NOTE: Deprecated. Use FilteredBlockDisconnectedNtfnMethod instead.
 	BlockDisconnectedNtfnMethod = "blockdisconnected"
 
+	// CapabilitiesNtfnMethod is the method used for notifications from the
+	// chain server, sent once a client connects, announcing the extension
+	// commands and notifications it supports.
+	CapabilitiesNtfnMethod = "capabilities"
+
 	// FilteredBlockConnectedNtfnMethod is the new method used for
 	// notifications from the chain server that a block has been connected.
 	FilteredBlockConnectedNtfnMethod = "filteredblockconnected"
@@ -71,10 +86,20 @@ const (
 	// more details in the notification.
 	TxAcceptedVerboseNtfnMethod = "txacceptedverbose"
 
+	// TxRejectedNtfnMethod is the method used for notifications from the
+	// chain server that a transaction was rejected by the mempool.
+	TxRejectedNtfnMethod = "txrejected"
+
 	// RelevantTxAcceptedNtfnMethod is the new method used for notifications
 	// from the chain server that inform a client that a transaction that
 	// matches the loaded filter was accepted by the mempool.
 	RelevantTxAcceptedNtfnMethod = "relevanttxaccepted"
+
+	// VerboseTxNtfnMethod is the method used for notifications from the
+	// chain server that a transaction paying to an address registered with
+	// notifynewtxsverbose was accepted.  The notification carries the fully
+	// decoded transaction.
+	VerboseTxNtfnMethod = "verbosetx"
 )
 
 // BlockConnectedNtfn defines the blockconnected JSON-RPC notification.
@@ -119,6 +144,41 @@ func NewBlockDisconnectedNtfn(hash string, height int32, time int64) *BlockDisco
 	}
 }
 
+// CapabilitiesNtfn defines the capabilities JSON-RPC notification.  Clients
+// may use it to avoid issuing extension commands, or waiting for extension
+// notifications, that the server does not support.
+type CapabilitiesNtfn struct {
+	Methods []string
+}
+
+// NewCapabilitiesNtfn returns a new instance which can be used to issue a
+// capabilities JSON-RPC notification.
+func NewCapabilitiesNtfn(methods []string) *CapabilitiesNtfn {
+	return &CapabilitiesNtfn{
+		Methods: methods,
+	}
+}
+
+// validateParams ensures the methods are an array of non-empty method names
+// that do not contain any whitespace.
+func (n *CapabilitiesNtfn) validateParams() error {
+	if n.Methods == nil {
+		return makeError(ErrInvalidParameter, "parameter 'methods' "+
+			"must be an array of strings")
+	}
+	return checkStringsParam("methods", "method name", n.Methods, 0,
+		func(method string) error {
+			if method == "" {
+				return errors.New("method name is empty")
+			}
+			if strings.IndexFunc(method, unicode.IsSpace) != -1 {
+				return errors.New("method name contains " +
+					"whitespace")
+			}
+			return nil
+		})
+}
+
 // FilteredBlockConnectedNtfn defines the filteredblockconnected JSON-RPC
 // notification.
 type FilteredBlockConnectedNtfn struct {
@@ -137,6 +197,44 @@ func NewFilteredBlockConnectedNtfn(height int32, header string, subscribedTxs []
 	}
 }
 
+// validateParams ensures the header is a hex-encoded serialized block header and
+// that each of the subscribed transactions is a hex-encoded serialized
+// transaction.
+func (n *FilteredBlockConnectedNtfn) validateParams() error {
+	if err := checkHeaderParam("header", n.Header); err != nil {
+		return err
+	}
+	for i, txHex := range n.SubscribedTxs {
+		err := checkTxParam(fmt.Sprintf("subscribedtxs[%d]", i), txHex)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkHeaderParam returns an error when the passed value of the named
+// parameter is not a hex-encoded serialized block header.
+func checkHeaderParam(name, headerHex string) error {
+	serializedHeader, err := decodeHexParam(name, headerHex)
+	if err != nil {
+		return err
+	}
+	if len(serializedHeader) != wire.MaxBlockHeaderPayload {
+		str := fmt.Sprintf("parameter '%s' must be %d bytes (got %d)",
+			name, wire.MaxBlockHeaderPayload, len(serializedHeader))
+		return makeError(ErrInvalidParameter, str)
+	}
+	var header wire.BlockHeader
+	err = header.Deserialize(bytes.NewReader(serializedHeader))
+	if err != nil {
+		str := fmt.Sprintf("parameter '%s' is not a valid block "+
+			"header: %v", name, err)
+		return makeError(ErrInvalidParameter, str)
+	}
+	return nil
+}
+
 // FilteredBlockDisconnectedNtfn defines the filteredblockdisconnected JSON-RPC
 // notification.
 type FilteredBlockDisconnectedNtfn struct {
@@ -153,6 +251,11 @@ func NewFilteredBlockDisconnectedNtfn(height int32, header string) *FilteredBloc
 	}
 }
 
+// validateParams ensures the header is a hex-encoded serialized block header.
+func (n *FilteredBlockDisconnectedNtfn) validateParams() error {
+	return checkHeaderParam("header", n.Header)
+}
+
 // BlockDetails describes details of a tx in a block.
 type BlockDetails struct {
 	Height int32  `json:"height"`
@@ -273,6 +376,21 @@ func NewTxAcceptedVerboseNtfn(rawTx TxRawResult) *TxAcceptedVerboseNtfn {
 	}
 }
 
+// TxRejectedNtfn defines the txrejected JSON-RPC notification.
+type TxRejectedNtfn struct {
+	TxID   string
+	Reason string
+}
+
+// NewTxRejectedNtfn returns a new instance which can be used to issue a
+// txrejected JSON-RPC notification.
+func NewTxRejectedNtfn(txHash string, reason string) *TxRejectedNtfn {
+	return &TxRejectedNtfn{
+		TxID:   txHash,
+		Reason: reason,
+	}
+}
+
 // RelevantTxAcceptedNtfn defines the parameters to the relevanttxaccepted
 // JSON-RPC notification.
 type RelevantTxAcceptedNtfn struct {
@@ -285,6 +403,25 @@ func NewRelevantTxAcceptedNtfn(txHex string) *RelevantTxAcceptedNtfn {
 	return &RelevantTxAcceptedNtfn{Transaction: txHex}
 }
 
+// validateParams ensures the transaction is a hex-encoded serialized
+// transaction.
+func (n *RelevantTxAcceptedNtfn) validateParams() error {
+	return checkTxParam("transaction", n.Transaction)
+}
+
+// VerboseTxNtfn defines the verbosetx JSON-RPC notification.
+type VerboseTxNtfn struct {
+	RawTx TxRawResult
+}
+
+// NewVerboseTxNtfn returns a new instance which can be used to issue a
+// verbosetx JSON-RPC notification.
+func NewVerboseTxNtfn(rawTx TxRawResult) *VerboseTxNtfn {
+	return &VerboseTxNtfn{
+		RawTx: rawTx,
+	}
+}
+
 func init() {
 	// The commands in this file are only usable by websockets and are
 	// notifications.
@@ -292,6 +429,7 @@ func init() {
 
 	MustRegisterCmd(BlockConnectedNtfnMethod, (*BlockConnectedNtfn)(nil), flags)
 	MustRegisterCmd(BlockDisconnectedNtfnMethod, (*BlockDisconnectedNtfn)(nil), flags)
+	MustRegisterCmd(CapabilitiesNtfnMethod, (*CapabilitiesNtfn)(nil), flags)
 	MustRegisterCmd(FilteredBlockConnectedNtfnMethod, (*FilteredBlockConnectedNtfn)(nil), flags)
 	MustRegisterCmd(FilteredBlockDisconnectedNtfnMethod, (*FilteredBlockDisconnectedNtfn)(nil), flags)
 	MustRegisterCmd(RecvTxNtfnMethod, (*RecvTxNtfn)(nil), flags)
@@ -300,5 +438,7 @@ func init() {
 	MustRegisterCmd(RescanProgressNtfnMethod, (*RescanProgressNtfn)(nil), flags)
 	MustRegisterCmd(TxAcceptedNtfnMethod, (*TxAcceptedNtfn)(nil), flags)
 	MustRegisterCmd(TxAcceptedVerboseNtfnMethod, (*TxAcceptedVerboseNtfn)(nil), flags)
+	MustRegisterCmd(TxRejectedNtfnMethod, (*TxRejectedNtfn)(nil), flags)
 	MustRegisterCmd(RelevantTxAcceptedNtfnMethod, (*RelevantTxAcceptedNtfn)(nil), flags)
+	MustRegisterCmd(VerboseTxNtfnMethod, (*VerboseTxNtfn)(nil), flags)
 }
@@ -10,11 +10,19 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/btcsuite/btcd/btcjson"
 )
 
+// ntfnTx and ntfnHeader are a serialized transaction and block header used to
+// test the notifications which carry hex-encoded transactions and headers.
+const (
+	ntfnTx     = "010000000111111111111111111111111111111111111111111111111111111111111111110000000000ffffffff01e803000000000000015100000000"
+	ntfnHeader = "0100000000000000000000000000000000000000000000000000000000000000000000003ba3edfd7a7b12b27ac72c3e67768f617fc81bc3888a51323a9fb8aa4b1e5e4a29ab5f49ffff001d1dac2b7c"
+)
+
 // TestChainSvrWsNtfns tests all of the chain server websocket-specific
 // notifications marshal and unmarshal into valid results include handling of
 // optional fields being omitted in the marshalled command, while optional
@@ -59,33 +67,59 @@ func TestChainSvrWsNtfns(t *testing.T) {
 				Time:   123456789,
 			},
 		},
+		{
+			name: "capabilities",
+			newNtfn: func() (interface{}, error) {
+				return btcjson.NewCmd("capabilities", []string{"notifyblocks", "loadtxfilter"})
+			},
+			staticNtfn: func() interface{} {
+				return btcjson.NewCapabilitiesNtfn([]string{"notifyblocks", "loadtxfilter"})
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"capabilities","params":[["notifyblocks","loadtxfilter"]],"id":null}`,
+			unmarshalled: &btcjson.CapabilitiesNtfn{
+				Methods: []string{"notifyblocks", "loadtxfilter"},
+			},
+		},
+		{
+			name: "capabilities none",
+			newNtfn: func() (interface{}, error) {
+				return btcjson.NewCmd("capabilities", []string{})
+			},
+			staticNtfn: func() interface{} {
+				return btcjson.NewCapabilitiesNtfn([]string{})
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"capabilities","params":[[]],"id":null}`,
+			unmarshalled: &btcjson.CapabilitiesNtfn{
+				Methods: []string{},
+			},
+		},
 		{
 			name: "filteredblockconnected",
 			newNtfn: func() (interface{}, error) {
-				return btcjson.NewCmd("filteredblockconnected", 100000, "header", []string{"tx0", "tx1"})
+				return btcjson.NewCmd("filteredblockconnected", 100000, ntfnHeader, []string{ntfnTx, ntfnTx})
 			},
 			staticNtfn: func() interface{} {
-				return btcjson.NewFilteredBlockConnectedNtfn(100000, "header", []string{"tx0", "tx1"})
+				return btcjson.NewFilteredBlockConnectedNtfn(100000, ntfnHeader, []string{ntfnTx, ntfnTx})
 			},
-			marshalled: `{"jsonrpc":"1.0","method":"filteredblockconnected","params":[100000,"header",["tx0","tx1"]],"id":null}`,
+			marshalled: `{"jsonrpc":"1.0","method":"filteredblockconnected","params":[100000,"` + ntfnHeader + `",["` + ntfnTx + `","` + ntfnTx + `"]],"id":null}`,
 			unmarshalled: &btcjson.FilteredBlockConnectedNtfn{
 				Height:        100000,
-				Header:        "header",
-				SubscribedTxs: []string{"tx0", "tx1"},
+				Header:        ntfnHeader,
+				SubscribedTxs: []string{ntfnTx, ntfnTx},
 			},
 		},
 		{
 			name: "filteredblockdisconnected",
 			newNtfn: func() (interface{}, error) {
-				return btcjson.NewCmd("filteredblockdisconnected", 100000, "header")
+				return btcjson.NewCmd("filteredblockdisconnected", 100000, ntfnHeader)
 			},
 			staticNtfn: func() interface{} {
-				return btcjson.NewFilteredBlockDisconnectedNtfn(100000, "header")
+				return btcjson.NewFilteredBlockDisconnectedNtfn(100000, ntfnHeader)
 			},
-			marshalled: `{"jsonrpc":"1.0","method":"filteredblockdisconnected","params":[100000,"header"],"id":null}`,
+			marshalled: `{"jsonrpc":"1.0","method":"filteredblockdisconnected","params":[100000,"` + ntfnHeader + `"],"id":null}`,
 			unmarshalled: &btcjson.FilteredBlockDisconnectedNtfn{
 				Height: 100000,
-				Header: "header",
+				Header: ntfnHeader,
 			},
 		},
 		{
@@ -212,17 +246,61 @@ func TestChainSvrWsNtfns(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "txrejected",
+			newNtfn: func() (interface{}, error) {
+				return btcjson.NewCmd("txrejected", "123", "insufficient fee")
+			},
+			staticNtfn: func() interface{} {
+				return btcjson.NewTxRejectedNtfn("123", "insufficient fee")
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"txrejected","params":["123","insufficient fee"],"id":null}`,
+			unmarshalled: &btcjson.TxRejectedNtfn{
+				TxID:   "123",
+				Reason: "insufficient fee",
+			},
+		},
 		{
 			name: "relevanttxaccepted",
 			newNtfn: func() (interface{}, error) {
-				return btcjson.NewCmd("relevanttxaccepted", "001122")
+				return btcjson.NewCmd("relevanttxaccepted", ntfnTx)
 			},
 			staticNtfn: func() interface{} {
-				return btcjson.NewRelevantTxAcceptedNtfn("001122")
+				return btcjson.NewRelevantTxAcceptedNtfn(ntfnTx)
 			},
-			marshalled: `{"jsonrpc":"1.0","method":"relevanttxaccepted","params":["001122"],"id":null}`,
+			marshalled: `{"jsonrpc":"1.0","method":"relevanttxaccepted","params":["` + ntfnTx + `"],"id":null}`,
 			unmarshalled: &btcjson.RelevantTxAcceptedNtfn{
-				Transaction: "001122",
+				Transaction: ntfnTx,
+			},
+		},
+		{
+			name: "verbosetx",
+			newNtfn: func() (interface{}, error) {
+				return btcjson.NewCmd("verbosetx", `{"hex":"001122","txid":"123","version":1,"locktime":4294967295,"vin":null,"vout":null,"confirmations":0}`)
+			},
+			staticNtfn: func() interface{} {
+				txResult := btcjson.TxRawResult{
+					Hex:           "001122",
+					Txid:          "123",
+					Version:       1,
+					LockTime:      4294967295,
+					Vin:           nil,
+					Vout:          nil,
+					Confirmations: 0,
+				}
+				return btcjson.NewVerboseTxNtfn(txResult)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"verbosetx","params":[{"hex":"001122","txid":"123","version":1,"locktime":4294967295,"vin":null,"vout":null}],"id":null}`,
+			unmarshalled: &btcjson.VerboseTxNtfn{
+				RawTx: btcjson.TxRawResult{
+					Hex:           "001122",
+					Txid:          "123",
+					Version:       1,
+					LockTime:      4294967295,
+					Vin:           nil,
+					Vout:          nil,
+					Confirmations: 0,
+				},
 			},
 		},
 	}
@@ -245,6 +323,13 @@ func TestChainSvrWsNtfns(t *testing.T) {
 			continue
 		}
 
+		// Ensure the params reported for the notification match the
+		// marshalled params.
+		if err := checkCmdParams(test.staticNtfn(), marshalled); err != nil {
+			t.Errorf("Test #%d (%s) %v", i, test.name, err)
+			continue
+		}
+
 		// Ensure the notification is created without error via the
 		// generic new notification creation function.
 		cmd, err := test.newNtfn()
@@ -294,3 +379,149 @@ func TestChainSvrWsNtfns(t *testing.T) {
 		}
 	}
 }
+
+// TestChainSvrWsNtfnParamErrors ensures UnmarshalCmd rejects chain server
+// websocket notifications whose parameters do not satisfy the requirements of
+// the command.
+func TestChainSvrWsNtfnParamErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []unmarshalCmdErrorTest{
+		{
+			name: "missing reason for txrejected",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "txrejected",
+				Params:  []json.RawMessage{[]byte(`"123"`)},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrTooFewParams},
+		},
+		{
+			name: "non-string reason for txrejected",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "txrejected",
+				Params: []json.RawMessage{[]byte(`"123"`),
+					[]byte(`1`)},
+				ID: nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidType},
+		},
+		{
+			name: "non-hex transaction for relevanttxaccepted",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "relevanttxaccepted",
+				Params:  []json.RawMessage{[]byte(`"xyz"`)},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name: "truncated transaction for relevanttxaccepted",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "relevanttxaccepted",
+				Params:  []json.RawMessage{[]byte(`"001122"`)},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name: "short header for filteredblockconnected",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "filteredblockconnected",
+				Params: []json.RawMessage{[]byte(`100000`), []byte(`"001122"`),
+					[]byte(`[]`)},
+				ID: nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name: "invalid transaction for filteredblockconnected",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "filteredblockconnected",
+				Params: []json.RawMessage{[]byte(`100000`),
+					[]byte(`"` + strings.Repeat("00", 80) + `"`),
+					[]byte(`["001122"]`)},
+				ID: nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name: "non-hex header for filteredblockdisconnected",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "filteredblockdisconnected",
+				Params:  []json.RawMessage{[]byte(`100000`), []byte(`"header"`)},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name: "long header for filteredblockdisconnected",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "filteredblockdisconnected",
+				Params: []json.RawMessage{[]byte(`100000`),
+					[]byte(`"` + strings.Repeat("00", 81) + `"`)},
+				ID: nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name: "capabilities methods not an array",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "capabilities",
+				Params:  []json.RawMessage{[]byte(`"notifyblocks"`)},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidType},
+		},
+		{
+			name: "capabilities methods null",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "capabilities",
+				Params:  []json.RawMessage{[]byte(`null`)},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name: "capabilities non-string method",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "capabilities",
+				Params:  []json.RawMessage{[]byte(`["notifyblocks",1]`)},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidType},
+		},
+		{
+			name: "capabilities empty method",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "capabilities",
+				Params:  []json.RawMessage{[]byte(`["notifyblocks",""]`)},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name: "capabilities method with whitespace",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "capabilities",
+				Params:  []json.RawMessage{[]byte(`["notify blocks"]`)},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+	}
+	testUnmarshalCmdErrors(t, tests)
+}
@@ -5,11 +5,129 @@
 
 package btcjson
 
+import (
+	"encoding/json"
+	"errors"
+)
+
+// AuthenticateResult models the data returned by the authenticate command.
+// Token identifies the authenticated session and Expiry is the unix time at
+// which it must be renewed.
+type AuthenticateResult struct {
+	Token  string `json:"token"`
+	Expiry int64  `json:"expiry"`
+}
+
 // SessionResult models the data from the session command.
 type SessionResult struct {
 	SessionID uint64 `json:"sessionid"`
 }
 
+// GetAddressBalanceResult models the data returned by the getaddressbalance
+// command.  The amounts are in satoshi.
+type GetAddressBalanceResult struct {
+	Balance  int64 `json:"balance"`
+	Received int64 `json:"received"`
+}
+
+// GetAddressDeltasResult models the data of a single balance change returned
+// by the getaddressdeltas command.  Satoshis is negative when the address is
+// spent from.
+type GetAddressDeltasResult struct {
+	Satoshis int64  `json:"satoshis"`
+	TxID     string `json:"txid"`
+	Index    uint32 `json:"index"`
+	Height   int32  `json:"height"`
+	Address  string `json:"address"`
+}
+
+// GetAddressMempoolResult models the data of a single balance change returned
+// by the getaddressmempool command.  The previous output fields are only set
+// when the address is spent from, in which case Satoshis is negative.
+type GetAddressMempoolResult struct {
+	Address   string  `json:"address"`
+	TxID      string  `json:"txid"`
+	Index     uint32  `json:"index"`
+	Satoshis  int64   `json:"satoshis"`
+	Timestamp int64   `json:"timestamp"`
+	PrevTxID  string  `json:"prevtxid,omitempty"`
+	PrevOut   *uint32 `json:"prevout,omitempty"`
+}
+
+// GetAddressUTXOsResult models the data of a single unspent output returned
+// by the getaddressutxos command.
+type GetAddressUTXOsResult struct {
+	Address     string `json:"address"`
+	TxID        string `json:"txid"`
+	OutputIndex uint32 `json:"outputIndex"`
+	Script      string `json:"script"`
+	Satoshis    int64  `json:"satoshis"`
+	Height      int32  `json:"height"`
+}
+
+// GetSpentInfoResult models the data returned by the getspentinfo command.
+type GetSpentInfoResult struct {
+	TxID   string `json:"txid"`
+	Index  uint32 `json:"index"`
+	Height int32  `json:"height"`
+}
+
+// ListBannedResult models the data of a single entry returned by the
+// listbanned command.
+type ListBannedResult struct {
+	Address     string `json:"address"`
+	BannedUntil int64  `json:"banned_until"`
+	BanCreated  int64  `json:"ban_created"`
+}
+
+// NotifyBlocksResult models the best block a server may reply with to the
+// notifyblocks command.  Blocks connected after it are delivered as
+// notifications.
+type NotifyBlocksResult struct {
+	Hash   string `json:"hash"`
+	Height int32  `json:"height"`
+}
+
+// NotifySpentResult models the acknowledgement a server may reply with to the
+// notifyspent command to confirm whether the outpoints are being watched.  The
+// error describes why registration failed and is only set when it did.
+type NotifySpentResult struct {
+	Registered bool    `json:"registered"`
+	Error      *string `json:"error,omitempty"`
+}
+
+// UnmarshalJSON unmarshals the notifyspent acknowledgement and ensures the
+// registered field is present so a reply of an unexpected shape is not
+// mistaken for a failed registration.
+func (r *NotifySpentResult) UnmarshalJSON(data []byte) error {
+	var reply struct {
+		Registered *bool   `json:"registered"`
+		Error      *string `json:"error"`
+	}
+	if err := json.Unmarshal(data, &reply); err != nil {
+		return err
+	}
+	if reply.Registered == nil {
+		return errors.New("notifyspent result is missing the " +
+			"registered field")
+	}
+
+	*r = NotifySpentResult{
+		Registered: *reply.Registered,
+		Error:      reply.Error,
+	}
+	return nil
+}
+
+// RescanResult models the summary some servers reply with to the rescan
+// command once it completes, as an alternative to only sending the
+// rescanfinished notification.
+type RescanResult struct {
+	LastBlockHash   string `json:"lastblockhash"`
+	LastBlockHeight int32  `json:"lastblockheight"`
+	TxsFound        int    `json:"txsfound"`
+}
+
 // RescannedBlock contains the hash and all discovered transactions of a single
 // rescanned block.
 //
@@ -19,3 +137,10 @@ type RescannedBlock struct {
 	Hash         string   `json:"hash"`
 	Transactions []string `json:"transactions"`
 }
+
+// WaitForBlockResult models the data returned by the waitfornewblock and
+// waitforblockheight commands.
+type WaitForBlockResult struct {
+	Hash   string `json:"hash"`
+	Height int32  `json:"height"`
+}
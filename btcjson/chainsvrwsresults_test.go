@@ -48,3 +48,46 @@ func TestChainSvrWsResults(t *testing.T) {
 		}
 	}
 }
+
+// TestNotifySpentResult ensures the notifyspent acknowledgement decodes both
+// successful and failed registrations and rejects replies of the wrong shape.
+func TestNotifySpentResult(t *testing.T) {
+	t.Parallel()
+
+	tests := []resultDecodeTest{
+		{
+			name:   "registered",
+			result: `{"registered":true}`,
+			expected: btcjson.NotifySpentResult{
+				Registered: true,
+			},
+		},
+		{
+			name:   "not registered",
+			result: `{"registered":false,"error":"invalid outpoint"}`,
+			expected: btcjson.NotifySpentResult{
+				Registered: false,
+				Error:      btcjson.String("invalid outpoint"),
+			},
+		},
+		{
+			name:    "missing registered",
+			result:  `{"error":"invalid outpoint"}`,
+			wantErr: true,
+		},
+		{
+			name:    "wrong registered type",
+			result:  `{"registered":"true"}`,
+			wantErr: true,
+		},
+		{
+			name:    "not an object",
+			result:  `true`,
+			wantErr: true,
+		},
+	}
+
+	testResultDecode(t, tests, func() interface{} {
+		return new(btcjson.NotifySpentResult)
+	})
+}
@@ -27,6 +27,39 @@ func CmdMethod(cmd interface{}) (string, error) {
 	return method, nil
 }
 
+// CmdParams returns the positional parameters of the passed command in the
+// same form MarshalCmd marshals them.  That is, unset optional parameters
+// which precede a set optional parameter are nil and any trailing unset
+// optional parameters are omitted.  This allows a command to be inspected
+// without a JSON round trip.  The provided command type must be a registered
+// type.  All commands provided by this package are registered by default.
+//
+// NOTE: The returned parameters include any secrets carried by the command,
+// such as passphrases and private keys, in the clear.  This is the case even
+// for commands whose String method redacts them, so callers must take care not
+// to log the returned parameters.
+func CmdParams(cmd interface{}) ([]interface{}, error) {
+	// Look up the cmd type and error out if not registered.
+	rt := reflect.TypeOf(cmd)
+	registerLock.RLock()
+	method, ok := concreteTypeToMethod[rt]
+	info := methodToInfo[method]
+	registerLock.RUnlock()
+	if !ok {
+		str := fmt.Sprintf("%q is not registered", method)
+		return nil, makeError(ErrUnregisteredMethod, str)
+	}
+
+	// The provided command must not be nil.
+	rv := reflect.ValueOf(cmd)
+	if rv.IsNil() {
+		str := "the specified command is nil"
+		return nil, makeError(ErrInvalidType, str)
+	}
+
+	return makeParams(rt.Elem(), rv.Elem(), info.defaults), nil
+}
+
 // MethodUsageFlags returns the usage flags for the passed command method.  The
 // provided method must be associated with a registered type.  All commands
 // provided by this package are registered by default.
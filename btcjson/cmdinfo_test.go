@@ -5,6 +5,9 @@
 package btcjson_test
 
 import (
+	"bytes"
+	"encoding/json"
+	"fmt"
 	"reflect"
 	"testing"
 
@@ -69,6 +72,105 @@ func TestCmdMethod(t *testing.T) {
 	}
 }
 
+// checkCmdParams returns an error when the params returned by CmdParams for the
+// passed command do not match the params of the passed marshalled request.
+func checkCmdParams(cmd interface{}, marshalled []byte) error {
+	params, err := btcjson.CmdParams(cmd)
+	if err != nil {
+		return fmt.Errorf("unexpected CmdParams error: %v", err)
+	}
+	gotParams, err := json.Marshal(params)
+	if err != nil {
+		return fmt.Errorf("unexpected error marshalling params: %v", err)
+	}
+
+	var request btcjson.Request
+	if err := json.Unmarshal(marshalled, &request); err != nil {
+		return fmt.Errorf("unexpected error unmarshalling request: %v",
+			err)
+	}
+	wantParams, err := json.Marshal(request.Params)
+	if err != nil {
+		return fmt.Errorf("unexpected error marshalling params: %v", err)
+	}
+
+	if !bytes.Equal(gotParams, wantParams) {
+		return fmt.Errorf("mismatched params - got %s, want %s",
+			gotParams, wantParams)
+	}
+	return nil
+}
+
+// TestCmdParams tests the CmdParams function to ensure it returns the expected
+// params and errors.
+func TestCmdParams(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		cmd    interface{}
+		params []interface{}
+		err    error
+	}{
+		{
+			name: "unregistered type",
+			cmd:  (*int)(nil),
+			err:  btcjson.Error{ErrorCode: btcjson.ErrUnregisteredMethod},
+		},
+		{
+			name: "nil pointer of registered type",
+			cmd:  (*btcjson.GetBlockCmd)(nil),
+			err:  btcjson.Error{ErrorCode: btcjson.ErrInvalidType},
+		},
+		{
+			name:   "no params",
+			cmd:    btcjson.NewGetBlockCountCmd(),
+			params: []interface{}{},
+		},
+		{
+			name:   "trailing optional params omitted",
+			cmd:    btcjson.NewGetTxOutCmd("123", 1, nil),
+			params: []interface{}{"123", uint32(1)},
+		},
+		{
+			name: "unset optional param before set one",
+			cmd: btcjson.NewVerifyChainCmd(nil, nil).
+				WithTimeout(60000),
+			params: []interface{}{nil, nil, btcjson.Int(60000)},
+		},
+		{
+			name:   "secret included",
+			cmd:    btcjson.NewWalletPassphraseCmd("pass", 60),
+			params: []interface{}{"pass", int64(60)},
+		},
+	}
+
+	t.Logf("Running %d tests", len(tests))
+	for i, test := range tests {
+		params, err := btcjson.CmdParams(test.cmd)
+		if reflect.TypeOf(err) != reflect.TypeOf(test.err) {
+			t.Errorf("Test #%d (%s) wrong error - got %T (%[3]v), "+
+				"want %T", i, test.name, err, test.err)
+			continue
+		}
+		if err != nil {
+			gotErrorCode := err.(btcjson.Error).ErrorCode
+			if gotErrorCode != test.err.(btcjson.Error).ErrorCode {
+				t.Errorf("Test #%d (%s) mismatched error code "+
+					"- got %v (%v), want %v", i, test.name,
+					gotErrorCode, err,
+					test.err.(btcjson.Error).ErrorCode)
+			}
+			continue
+		}
+
+		if !reflect.DeepEqual(params, test.params) {
+			t.Errorf("Test #%d (%s) mismatched params - got %#v, "+
+				"want %#v", i, test.name, params, test.params)
+		}
+	}
+}
+
 // TestMethodUsageFlags tests the MethodUsage function ensure it returns the
 // expected flags and errors.
 func TestMethodUsageFlags(t *testing.T) {
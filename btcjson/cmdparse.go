@@ -5,30 +5,156 @@
 package btcjson
 
 import (
+	"bytes"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"io"
+	"math"
+	"math/big"
 	"reflect"
 	"strconv"
 	"strings"
+	"sync/atomic"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
 )
 
-// makeParams creates a slice of interface values for the given struct.
-func makeParams(rt reflect.Type, rv reflect.Value) []interface{} {
+// makeParams creates a slice of interface values for the given struct.  The
+// defaults are the registered default values of the command's optional fields.
+func makeParams(rt reflect.Type, rv reflect.Value, defaults map[int]reflect.Value) []interface{} {
 	numFields := rt.NumField()
 	params := make([]interface{}, 0, numFields)
+	numSet := 0
 	for i := 0; i < numFields; i++ {
 		rtf := rt.Field(i)
 		rvf := rv.Field(i)
 		if rtf.Type.Kind() == reflect.Ptr {
 			if rvf.IsNil() {
-				break
+				// Unset nullable parameters are marshalled as
+				// null so any set optional parameters that
+				// follow keep their position, while any other
+				// unset optional parameter ends the params.
+				// Trailing nulls are removed below.
+				if !isNullable(rtf) {
+					break
+				}
+				params = append(params, nil)
+				continue
+			}
+
+			// Parameters set to a default which is omitted are
+			// only kept when a later parameter is set.
+			if isOmittedDefault(rtf, rvf, defaults[i]) {
+				params = append(params, rvf.Interface())
+				continue
 			}
-			rvf.Elem()
 		}
-		params = append(params, rvf.Interface())
+		param := rvf.Interface()
+		if isAmountField(rtf) && atomic.LoadInt32(&amountsAsStrings) != 0 {
+			param = amountAsString(param)
+		}
+		params = append(params, param)
+		numSet = len(params)
 	}
 
-	return params
+	return params[:numSet]
+}
+
+// isNullable returns whether the passed optional command field is marked with a
+// 'jsonrpcnullable' struct tag, in which case it is marshalled as null when it
+// is unset and a later optional field is set, and a null param unmarshals to
+// its default value.
+func isNullable(rtf reflect.StructField) bool {
+	return rtf.Tag.Get("jsonrpcnullable") == "true"
+}
+
+// isOmittedDefault returns whether the passed optional command field is set to
+// its default value and is marked with a 'jsonrpcomitdefault' struct tag, in
+// which case it is marshalled as though it were unset.
+func isOmittedDefault(rtf reflect.StructField, rvf reflect.Value,
+	defaultVal reflect.Value) bool {
+
+	if rtf.Tag.Get("jsonrpcomitdefault") != "true" || !defaultVal.IsValid() {
+		return false
+	}
+	return reflect.DeepEqual(rvf.Interface(), defaultVal.Interface())
+}
+
+// amountsAsStrings is non-zero when MarshalCmd marshals amounts as strings
+// with a fixed eight decimal places.  It is accessed atomically.
+var amountsAsStrings int32
+
+// SetAmountsAsStrings sets whether MarshalCmd marshals the amounts of commands
+// such as sendtoaddress and sendmany as strings with a fixed eight decimal
+// places, for example "0.00000001", rather than as JSON numbers which a server
+// may round when parsing them as floating point.  The default is to marshal
+// numbers for compatibility with existing servers.  UnmarshalCmd accepts both
+// forms regardless.  It is safe for concurrent use.
+func SetAmountsAsStrings(asStrings bool) {
+	var v int32
+	if asStrings {
+		v = 1
+	}
+	atomic.StoreInt32(&amountsAsStrings, v)
+}
+
+// isAmountField returns whether the passed command field is an amount in BTC as
+// indicated by its 'jsonrpcamount' struct tag.
+func isAmountField(rtf reflect.StructField) bool {
+	return rtf.Tag.Get("jsonrpcamount") == "true"
+}
+
+// formatAmount returns the passed amount in BTC as a string with a fixed eight
+// decimal places.
+func formatAmount(amount float64) string {
+	return strconv.FormatFloat(amount, 'f', 8, 64)
+}
+
+// amountAsString returns the passed amount, or map of amounts, with each
+// amount formatted as a fixed decimal string.  Values of any other type are
+// returned unchanged.
+func amountAsString(amount interface{}) interface{} {
+	switch v := amount.(type) {
+	case float64:
+		return formatAmount(v)
+	case *float64:
+		return formatAmount(*v)
+	case map[string]float64:
+		amounts := make(map[string]string, len(v))
+		for k, amt := range v {
+			amounts[k] = formatAmount(amt)
+		}
+		return amounts
+	}
+	return amount
+}
+
+// omitEmptyParams is non-zero when MarshalCmd omits the params field of
+// requests which have no params.  It is accessed atomically.
+var omitEmptyParams int32
+
+// SetOmitEmptyParams sets whether MarshalCmd omits the params field entirely
+// for requests which have no params, such as getbestblock, rather than
+// marshalling it as an empty array.  The default is to marshal "params":[] for
+// compatibility with existing servers.  It is safe for concurrent use.
+func SetOmitEmptyParams(omit bool) {
+	var v int32
+	if omit {
+		v = 1
+	}
+	atomic.StoreInt32(&omitEmptyParams, v)
+}
+
+// requestWithoutParams is the form of a Request marshalled when it has no
+// params and they are configured to be omitted.
+type requestWithoutParams struct {
+	Jsonrpc string      `json:"jsonrpc"`
+	Method  string      `json:"method"`
+	ID      interface{} `json:"id"`
 }
 
 // MarshalCmd marshals the passed command to a JSON-RPC request byte slice that
@@ -40,6 +166,7 @@ func MarshalCmd(id interface{}, cmd interface{}) ([]byte, error) {
 	rt := reflect.TypeOf(cmd)
 	registerLock.RLock()
 	method, ok := concreteTypeToMethod[rt]
+	info := methodToInfo[method]
 	registerLock.RUnlock()
 	if !ok {
 		str := fmt.Sprintf("%q is not registered", method)
@@ -56,34 +183,158 @@ func MarshalCmd(id interface{}, cmd interface{}) ([]byte, error) {
 	// Create a slice of interface values in the order of the struct fields
 	// while respecting pointer fields as optional params and only adding
 	// them if they are non-nil.
-	params := makeParams(rt.Elem(), rv.Elem())
+	params := makeParams(rt.Elem(), rv.Elem(), info.defaults)
 
 	// Generate and marshal the final JSON-RPC request.
 	rawCmd, err := NewRequest(id, method, params)
 	if err != nil {
 		return nil, err
 	}
+	if len(rawCmd.Params) == 0 && atomic.LoadInt32(&omitEmptyParams) != 0 {
+		return json.Marshal(&requestWithoutParams{
+			Jsonrpc: rawCmd.Jsonrpc,
+			Method:  rawCmd.Method,
+			ID:      rawCmd.ID,
+		})
+	}
 	return json.Marshal(rawCmd)
 }
 
 // checkNumParams ensures the supplied number of params is at least the minimum
-// required number for the command and less than the maximum allowed.
+// required number for the command and less than the maximum allowed.  The
+// returned error is ErrTooFewParams or ErrTooManyParams accordingly.
 func checkNumParams(numParams int, info *methodInfo) error {
-	if numParams < info.numReqParams || numParams > info.maxParams {
-		if info.numReqParams == info.maxParams {
-			str := fmt.Sprintf("wrong number of params (expected "+
-				"%d, received %d)", info.numReqParams,
-				numParams)
-			return makeError(ErrNumParams, str)
+	var code ErrorCode
+	switch {
+	case numParams < info.numReqParams:
+		code = ErrTooFewParams
+	case numParams > info.maxParams:
+		code = ErrTooManyParams
+	default:
+		return nil
+	}
+
+	if info.numReqParams == info.maxParams {
+		str := fmt.Sprintf("wrong number of params (expected %d, "+
+			"received %d)", info.numReqParams, numParams)
+		return makeError(code, str)
+	}
+
+	str := fmt.Sprintf("wrong number of params (expected between %d and "+
+		"%d, received %d)", info.numReqParams, info.maxParams,
+		numParams)
+	return makeError(code, str)
+}
+
+// lenientParams is non-zero when UnmarshalCmd ignores any params beyond the
+// maximum accepted by a command rather than rejecting the request and accepts
+// integral numbers written with a fraction or exponent for integer params.  It
+// is accessed atomically.
+var lenientParams int32
+
+// SetLenientParams sets whether UnmarshalCmd ignores unexpected trailing params,
+// such as additional optional params appended by newer servers, rather than
+// returning ErrTooManyParams.  Lenient parsing also accepts integer params
+// written with a fraction or exponent, such as 1e3 or 100.0, when their value
+// is integral.  The default is strict parsing.  It is safe for concurrent use.
+func SetLenientParams(lenient bool) {
+	var v int32
+	if lenient {
+		v = 1
+	}
+	atomic.StoreInt32(&lenientParams, v)
+}
+
+// rejectDuplicateKeys is non-zero when UnmarshalCmd rejects params containing
+// objects with duplicate keys.  It is accessed atomically.
+var rejectDuplicateKeys int32
+
+// SetRejectDuplicateKeys sets whether UnmarshalCmd rejects params which contain
+// an object with a duplicate key, such as a sendmany amounts object naming the
+// same address twice or a rescan outpoint with a repeated index, rather than
+// silently keeping the last value as encoding/json does.  The default is to
+// accept them.  It is safe for concurrent use.
+func SetRejectDuplicateKeys(reject bool) {
+	var v int32
+	if reject {
+		v = 1
+	}
+	atomic.StoreInt32(&rejectDuplicateKeys, v)
+}
+
+// duplicateKeyFrame tracks the state of an object or array being scanned by
+// findDuplicateKey.  The keys map is nil for arrays.
+type duplicateKeyFrame struct {
+	keys      map[string]struct{}
+	expectKey bool
+}
+
+// findDuplicateKey scans the passed JSON value and returns the first key which
+// appears more than once in the same object, if any.  Malformed JSON is not
+// reported since it is rejected when the value is unmarshalled.
+func findDuplicateKey(data []byte) (string, bool) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var stack []*duplicateKeyFrame
+	for {
+		tok, err := dec.Token()
+		if err != nil {
+			return "", false
 		}
 
-		str := fmt.Sprintf("wrong number of params (expected "+
-			"between %d and %d, received %d)", info.numReqParams,
-			info.maxParams, numParams)
-		return makeError(ErrNumParams, str)
+		// Keys are reported as plain strings, so consume them when the
+		// innermost frame is an object which expects one.
+		var top *duplicateKeyFrame
+		if len(stack) > 0 {
+			top = stack[len(stack)-1]
+		}
+		if top != nil && top.keys != nil && top.expectKey {
+			if key, ok := tok.(string); ok {
+				if _, ok := top.keys[key]; ok {
+					return key, true
+				}
+				top.keys[key] = struct{}{}
+				top.expectKey = false
+				continue
+			}
+		}
+
+		switch tok {
+		case json.Delim('{'):
+			stack = append(stack, &duplicateKeyFrame{
+				keys:      make(map[string]struct{}),
+				expectKey: true,
+			})
+			continue
+		case json.Delim('['):
+			stack = append(stack, &duplicateKeyFrame{})
+			continue
+		case json.Delim('}'), json.Delim(']'):
+			stack = stack[:len(stack)-1]
+		}
+
+		// A complete value was read, so the enclosing object, if any,
+		// expects a key next.
+		if len(stack) == 0 {
+			return "", false
+		}
+		if parent := stack[len(stack)-1]; parent.keys != nil {
+			parent.expectKey = true
+		}
 	}
+}
 
-	return nil
+// numParamsToParse returns the number of the supplied params which should be
+// parsed into the command, which excludes any unexpected trailing params when
+// parsing is lenient, or an error when the number is not valid for the command.
+func numParamsToParse(numParams int, info *methodInfo) (int, error) {
+	if numParams > info.maxParams && atomic.LoadInt32(&lenientParams) != 0 {
+		numParams = info.maxParams
+	}
+	if err := checkNumParams(numParams, info); err != nil {
+		return 0, err
+	}
+	return numParams, nil
 }
 
 // populateDefaults populates default values into any remaining optional struct
@@ -99,14 +350,524 @@ func populateDefaults(numParams int, info *methodInfo, rv reflect.Value) {
 	for i := numParams; i < info.maxParams; i++ {
 		rvf := rv.Field(i)
 		if defaultVal, ok := info.defaults[i]; ok {
-			rvf.Set(defaultVal)
+			setDefault(rvf, defaultVal)
+		}
+	}
+}
+
+// setDefault sets the passed optional field to a newly allocated copy of the
+// passed registered default value.  The registered value itself must not be
+// assigned since it would then be shared by every command parsed afterwards,
+// and modifying the field of one of them would change the default.
+func setDefault(rvf reflect.Value, defaultVal reflect.Value) {
+	v := reflect.New(defaultVal.Type().Elem())
+	v.Elem().Set(defaultVal.Elem())
+	rvf.Set(v)
+}
+
+// accountParams maps the methods which take account names to the fields of
+// their commands which hold them.  Only these fields are checked by
+// checkAccountParams, so commands registered outside of this package are
+// never affected.
+var accountParams = map[string][]string{
+	"addmultisigaddress":      {"Account"},
+	"createnewaccount":        {"Account"},
+	"exportwatchingwallet":    {"Account"},
+	"getaccountaddress":       {"Account"},
+	"getaccountinfo":          {"Account"},
+	"getaddressesbyaccount":   {"Account"},
+	"getbalance":              {"Account"},
+	"getnewaddress":           {"Account"},
+	"getrawchangeaddress":     {"Account"},
+	"getreceivedbyaccount":    {"Account"},
+	"getunconfirmedbalance":   {"Account"},
+	"listaddresstransactions": {"Account"},
+	"listalltransactions":     {"Account"},
+	"listtransactions":        {"Account"},
+	"move":                    {"FromAccount", "ToAccount"},
+	"recoveraddresses":        {"Account"},
+	"renameaccount":           {"OldAccount", "NewAccount"},
+	"sendfrom":                {"FromAccount"},
+	"sendmany":                {"FromAccount"},
+	"setaccount":              {"Account"},
+	"sweepaccount":            {"SourceAccount"},
+}
+
+// checkAccountParam returns an error when the passed value of the named
+// parameter is an ambiguous account name.  The default account is the empty
+// string and whitespace is significant in account names, so a name which
+// consists only of whitespace or which has leading or trailing whitespace is
+// rejected rather than guessing whether the default account, the "*" wildcard
+// or the name as given was intended.
+func checkAccountParam(name, account string) error {
+	if account == "" || strings.TrimSpace(account) == account {
+		return nil
+	}
+	str := fmt.Sprintf("parameter '%s' must not have leading or "+
+		"trailing whitespace (got %q)", name, account)
+	return makeError(ErrInvalidParameter, str)
+}
+
+// checkAccountParams checks each of the account name parameters of the passed
+// command struct for the given method with checkAccountParam.
+func checkAccountParams(method string, rv reflect.Value) error {
+	for _, fieldName := range accountParams[method] {
+		rvf := rv.FieldByName(fieldName)
+		if rvf.Kind() == reflect.Ptr {
+			if rvf.IsNil() {
+				continue
+			}
+			rvf = rvf.Elem()
+		}
+		name := strings.ToLower(fieldName)
+		if err := checkAccountParam(name, rvf.String()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var (
+	// minIntegralParam and maxIntegralParam are the bounds of the values
+	// integralParam converts.  They cover all of the integer types, and
+	// bounding them prevents a huge exponent from materializing an
+	// enormous integer.
+	minIntegralParam = new(big.Float).SetInt64(math.MinInt64)
+	maxIntegralParam = new(big.Float).SetUint64(math.MaxUint64)
+)
+
+// jsonUnmarshalerType is the reflect type of the json.Unmarshaler interface.
+var jsonUnmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+
+// integralParam returns the passed parameter with every number destined for an
+// integer field rewritten as a plain JSON integer when it is written with a
+// fraction or exponent which nonetheless has an integral value, such as 1e3 or
+// 100.0.  json.Unmarshal rejects such numbers for integer types even though
+// lenient clients send them.  Integer fields nested within structs, slices,
+// arrays and maps, such as the vout of a transaction input, are rewritten as
+// well.  The parameter is returned unchanged otherwise so the usual type error
+// is reported.
+func integralParam(rt reflect.Type, param json.RawMessage) json.RawMessage {
+	rewritten, _ := rewriteIntegral(rt, param)
+	return rewritten
+}
+
+// rewriteIntegral implements integralParam and additionally reports whether
+// the parameter was rewritten so unchanged containers are not re-encoded.
+// Types which implement json.Unmarshaler decode themselves and are therefore
+// left untouched.
+func rewriteIntegral(rt reflect.Type, param json.RawMessage) (json.RawMessage, bool) {
+	for rt.Kind() == reflect.Ptr {
+		rt = rt.Elem()
+	}
+	if reflect.PtrTo(rt).Implements(jsonUnmarshalerType) {
+		return param, false
+	}
+
+	switch rt.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32,
+		reflect.Int64, reflect.Uint, reflect.Uint8, reflect.Uint16,
+		reflect.Uint32, reflect.Uint64:
+
+		return integralNumber(param)
+
+	case reflect.Slice, reflect.Array:
+		var elems []json.RawMessage
+		if err := json.Unmarshal(param, &elems); err != nil {
+			return param, false
+		}
+		var changed bool
+		for i, elem := range elems {
+			if r, ok := rewriteIntegral(rt.Elem(), elem); ok {
+				elems[i] = r
+				changed = true
+			}
+		}
+		if !changed {
+			return param, false
+		}
+		return remarshalParam(param, elems)
+
+	case reflect.Map, reflect.Struct:
+		var vals map[string]json.RawMessage
+		if err := json.Unmarshal(param, &vals); err != nil {
+			return param, false
+		}
+		var changed bool
+		for k, v := range vals {
+			var elemType reflect.Type
+			if rt.Kind() == reflect.Struct {
+				f, ok := jsonField(rt, k)
+				if !ok {
+					continue
+				}
+				elemType = f.Type
+			} else {
+				elemType = rt.Elem()
+			}
+			if r, ok := rewriteIntegral(elemType, v); ok {
+				vals[k] = r
+				changed = true
+			}
+		}
+		if !changed {
+			return param, false
 		}
+		return remarshalParam(param, vals)
+	}
+
+	return param, false
+}
+
+// integralNumber returns the passed number rewritten as a plain JSON integer
+// when it is written with a fraction or exponent and has an integral value
+// within the range of the integer types.
+func integralNumber(param json.RawMessage) (json.RawMessage, bool) {
+	str := strings.TrimSpace(string(param))
+	if !strings.ContainsAny(str, ".eE") {
+		return param, false
+	}
+	if len(str) == 0 || (str[0] != '-' && (str[0] < '0' || str[0] > '9')) {
+		return param, false
+	}
+	f, _, err := big.ParseFloat(str, 10, 256, big.ToNearestEven)
+	if err != nil || !f.IsInt() || f.Cmp(minIntegralParam) < 0 ||
+		f.Cmp(maxIntegralParam) > 0 {
+
+		return param, false
 	}
+	i, _ := f.Int(nil)
+	return json.RawMessage(i.String()), true
+}
+
+// remarshalParam returns the JSON encoding of the passed rewritten value, or
+// the original parameter should it fail to encode.
+func remarshalParam(param json.RawMessage, v interface{}) (json.RawMessage, bool) {
+	rewritten, err := json.Marshal(v)
+	if err != nil {
+		return param, false
+	}
+	return rewritten, true
+}
+
+// jsonField returns the exported field of the passed struct type which
+// encoding/json decodes the passed object key into.  Like encoding/json, an
+// exact match of the field name is preferred over a case-insensitive one.
+func jsonField(rt reflect.Type, key string) (reflect.StructField, bool) {
+	var fold reflect.StructField
+	var found bool
+	for i := 0; i < rt.NumField(); i++ {
+		f := rt.Field(i)
+		if f.PkgPath != "" {
+			continue
+		}
+		name := f.Name
+		if tag := f.Tag.Get("json"); tag != "" {
+			if tag == "-" {
+				continue
+			}
+			if tagName := strings.Split(tag, ",")[0]; tagName != "" {
+				name = tagName
+			}
+		}
+		if name == key {
+			return f, true
+		}
+		if !found && strings.EqualFold(name, key) {
+			fold, found = f, true
+		}
+	}
+	return fold, found
+}
+
+// amountParam returns the passed parameter with any amounts given as decimal
+// strings, such as "0.00000001", rewritten as JSON numbers when it is destined
+// for an amount field.  Both a single amount and an object of amounts are
+// handled.  The parameter is returned unchanged otherwise so the usual type
+// error is reported.
+func amountParam(rtf reflect.StructField, param json.RawMessage) json.RawMessage {
+	if !isAmountField(rtf) {
+		return param
+	}
+
+	str := strings.TrimSpace(string(param))
+	switch {
+	case strings.HasPrefix(str, `"`):
+		if amount, ok := stringAmount(param); ok {
+			return amount
+		}
+
+	case strings.HasPrefix(str, "{"):
+		var amounts map[string]json.RawMessage
+		if err := json.Unmarshal(param, &amounts); err != nil {
+			return param
+		}
+		for k, v := range amounts {
+			if amount, ok := stringAmount(v); ok {
+				amounts[k] = amount
+			}
+		}
+		rewritten, err := json.Marshal(amounts)
+		if err != nil {
+			return param
+		}
+		return rewritten
+	}
+	return param
+}
+
+// stringAmount returns the JSON number contained in the passed JSON string, if
+// it contains one.
+func stringAmount(param json.RawMessage) (json.RawMessage, bool) {
+	var str string
+	if err := json.Unmarshal(param, &str); err != nil {
+		return nil, false
+	}
+	if _, err := strconv.ParseFloat(str, 64); err != nil {
+		return nil, false
+	}
+	var number json.Number
+	if err := json.Unmarshal([]byte(str), &number); err != nil {
+		return nil, false
+	}
+	return json.RawMessage(str), true
+}
+
+// paramsValidator is implemented by commands whose parameters must satisfy
+// requirements that can't be expressed by their types alone, such as a string
+// which must be a hex-encoded hash.  UnmarshalCmd invokes it once all of the
+// parameters have been unmarshalled and any defaults populated.
+type paramsValidator interface {
+	validateParams() error
+}
+
+// checkHashParam returns an error when the passed value of the named
+// parameter is not a valid hex-encoded hash.
+func checkHashParam(name, hash string) error {
+	if _, err := chainhash.NewHashFromStr(hash); err != nil {
+		str := fmt.Sprintf("parameter '%s' must be a hex-encoded "+
+			"hash: %v", name, err)
+		return makeError(ErrInvalidParameter, str)
+	}
+	return nil
+}
+
+// checkPubKeyParam returns an error when the passed value of the named
+// parameter is not a hex-encoded 33-byte compressed or 65-byte uncompressed
+// public key.
+func checkPubKeyParam(name, pubKey string) error {
+	b, err := decodeHexParam(name, pubKey)
+	if err != nil {
+		return err
+	}
+	if len(b) != 33 && len(b) != 65 {
+		str := fmt.Sprintf("parameter '%s' must be a 33 or 65 byte "+
+			"public key (got %d bytes)", name, len(b))
+		return makeError(ErrInvalidParameter, str)
+	}
+	return nil
+}
+
+// decodeHexParam returns the bytes of the passed hex-encoded value of the named
+// parameter or an error when it is not valid hex.
+func decodeHexParam(name, hexStr string) ([]byte, error) {
+	b, err := hex.DecodeString(hexStr)
+	if err != nil {
+		str := fmt.Sprintf("parameter '%s' must be hex-encoded: %v",
+			name, err)
+		return nil, makeError(ErrInvalidParameter, str)
+	}
+	return b, nil
+}
+
+// checkTxParam returns an error when the passed value of the named parameter is
+// not a hex-encoded serialized transaction.  A transaction without inputs, such
+// as one passed to fundrawtransaction, is indistinguishable from the start of
+// the witness encoding, so the legacy encoding is tried when the witness
+// encoding fails to decode.
+func checkTxParam(name, txHex string) error {
+	serializedTx, err := decodeHexParam(name, txHex)
+	if err != nil {
+		return err
+	}
+	var msgTx wire.MsgTx
+	err = msgTx.Deserialize(bytes.NewReader(serializedTx))
+	if err != nil {
+		err = msgTx.DeserializeNoWitness(bytes.NewReader(serializedTx))
+	}
+	if err != nil {
+		str := fmt.Sprintf("parameter '%s' is not a valid transaction: "+
+			"%v", name, err)
+		return makeError(ErrInvalidParameter, str)
+	}
+	return nil
+}
+
+// checkAddressParam returns an error when the passed value of the named
+// parameter is not a valid address for one of the registered networks.
+func checkAddressParam(name, addr string) error {
+	_, err := btcutil.DecodeAddress(addr, &chaincfg.MainNetParams)
+	if err != nil {
+		str := fmt.Sprintf("parameter '%s' (%s) is not a valid "+
+			"address: %v", name, addr, err)
+		return makeError(ErrInvalidParameter, str)
+	}
+	return nil
+}
+
+// DefaultMaxPassphraseLen is the default maximum length, in bytes, of the
+// passphrases accepted by UnmarshalCmd.  It is far longer than any reasonable
+// passphrase while still bounding the work done to process one.
+const DefaultMaxPassphraseLen = 1024
+
+// maxAddresses and maxPassphraseLen are the maximum number of addresses a
+// single parameter may contain and the maximum length of a passphrase accepted
+// by UnmarshalCmd.  Zero means there is no limit, which is the default for the
+// number of addresses.  They are accessed atomically.
+var (
+	maxAddresses     int64
+	maxPassphraseLen int64 = DefaultMaxPassphraseLen
+)
+
+// SetMaxAddresses sets the maximum number of addresses UnmarshalCmd accepts in
+// a single parameter, such as the addresses of a loadtxfilter request.  Zero
+// or a negative value removes the limit, which is the default.  It is safe for
+// concurrent use.
+func SetMaxAddresses(n int) {
+	if n < 0 {
+		n = 0
+	}
+	atomic.StoreInt64(&maxAddresses, int64(n))
+}
+
+// MaxAddresses returns the maximum number of addresses UnmarshalCmd accepts in
+// a single parameter, or zero when there is no limit.  It is safe for
+// concurrent use.
+func MaxAddresses() int {
+	return int(atomic.LoadInt64(&maxAddresses))
+}
+
+// SetMaxPassphraseLen sets the maximum length, in bytes, of the passphrases
+// UnmarshalCmd accepts.  Zero or a negative value removes the limit.  The
+// default is DefaultMaxPassphraseLen.  It is safe for concurrent use.
+func SetMaxPassphraseLen(n int) {
+	if n < 0 {
+		n = 0
+	}
+	atomic.StoreInt64(&maxPassphraseLen, int64(n))
+}
+
+// MaxPassphraseLen returns the maximum length, in bytes, of the passphrases
+// UnmarshalCmd accepts, or zero when there is no limit.  It is safe for
+// concurrent use.
+func MaxPassphraseLen() int {
+	return int(atomic.LoadInt64(&maxPassphraseLen))
+}
+
+// minPassphraseLen is the minimum length of the passphrase of a new wallet
+// accepted by UnmarshalCmd.  It defaults to one since an empty passphrase
+// would result in a wallet that is effectively unencrypted.  It is accessed
+// atomically.
+var minPassphraseLen int64 = 1
+
+// SetMinPassphraseLen sets the minimum length, in bytes, of the passphrase of
+// a new wallet, such as the one given to createencryptedwallet.  Zero or a
+// negative value removes the limit.  It is safe for concurrent use.
+func SetMinPassphraseLen(n int) {
+	if n < 0 {
+		n = 0
+	}
+	atomic.StoreInt64(&minPassphraseLen, int64(n))
+}
+
+// MinPassphraseLen returns the minimum length, in bytes, of the passphrase of
+// a new wallet.  It is safe for concurrent use.
+func MinPassphraseLen() int {
+	return int(atomic.LoadInt64(&minPassphraseLen))
+}
+
+// checkNewPassphraseParam returns an error when the passed value of the named
+// parameter is shorter than the minimum or longer than the maximum passphrase
+// length.  The passphrase itself is never included in the error.
+func checkNewPassphraseParam(name, passphrase string) error {
+	if min := MinPassphraseLen(); len(passphrase) < min {
+		if len(passphrase) == 0 {
+			str := fmt.Sprintf("parameter '%s' must not be empty",
+				name)
+			return makeError(ErrInvalidParameter, str)
+		}
+		str := fmt.Sprintf("parameter '%s' must be at least %d bytes "+
+			"(got %d)", name, min, len(passphrase))
+		return makeError(ErrInvalidParameter, str)
+	}
+	return checkPassphraseParam(name, passphrase)
+}
+
+// checkPassphraseParam returns an error when the passed value of the named
+// parameter is longer than the maximum passphrase length.  The passphrase
+// itself is never included in the error.
+func checkPassphraseParam(name, passphrase string) error {
+	if max := MaxPassphraseLen(); max > 0 && len(passphrase) > max {
+		str := fmt.Sprintf("parameter '%s' must be at most %d bytes "+
+			"(got %d)", name, max, len(passphrase))
+		return makeError(ErrInvalidParameter, str)
+	}
+	return nil
+}
+
+// checkAddressesParam returns an error when the passed values of the named
+// parameter exceed the maximum number of addresses or any of them is not a
+// valid address for one of the registered networks.
+func checkAddressesParam(name string, addrs []string) error {
+	return checkStringsParam(name, "address", addrs, MaxAddresses(),
+		func(addr string) error {
+			_, err := btcutil.DecodeAddress(addr,
+				&chaincfg.MainNetParams)
+			return err
+		})
+}
+
+// checkOutPointsParam returns an error when any of the passed outpoints of the
+// named parameter does not refer to a valid transaction hash.
+func checkOutPointsParam(name string, ops []OutPoint) error {
+	for i, op := range ops {
+		err := checkHashParam(fmt.Sprintf("%s[%d].hash", name, i),
+			op.Hash)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkStringsParam returns an error when the passed values of the named
+// parameter number more than max, when max is positive, or the check function
+// rejects any of them.  The kind describes a single value in the error.
+func checkStringsParam(name, kind string, vals []string, max int,
+	check func(string) error) error {
+
+	if max > 0 && len(vals) > max {
+		str := fmt.Sprintf("parameter '%s' must contain at most %d "+
+			"entries (got %d)", name, max, len(vals))
+		return makeError(ErrInvalidParameter, str)
+	}
+	for i, val := range vals {
+		if err := check(val); err != nil {
+			str := fmt.Sprintf("parameter '%s' entry #%d (%s) is "+
+				"not a valid %s: %v", name, i, val, kind, err)
+			return makeError(ErrInvalidParameter, str)
+		}
+	}
+	return nil
 }
 
 // UnmarshalCmd unmarshals a JSON-RPC request into a suitable concrete command
 // so long as the method type contained within the marshalled request is
 // registered.
+//
+// A new command is always allocated, so the returned command never shares
+// memory with a previously returned one, even for identical requests.  There is
+// no way to unmarshal into an existing command.
 func UnmarshalCmd(r *Request) (interface{}, error) {
 	registerLock.RLock()
 	rtp, ok := methodToConcreteType[r.Method]
@@ -121,8 +882,8 @@ func UnmarshalCmd(r *Request) (interface{}, error) {
 	rv := rvp.Elem()
 
 	// Ensure the number of parameters are correct.
-	numParams := len(r.Params)
-	if err := checkNumParams(numParams, &info); err != nil {
+	numParams, err := numParamsToParse(len(r.Params), &info)
+	if err != nil {
 		return nil, err
 	}
 
@@ -132,7 +893,20 @@ func UnmarshalCmd(r *Request) (interface{}, error) {
 		rvf := rv.Field(i)
 		// Unmarshal the parameter into the struct field.
 		concreteVal := rvf.Addr().Interface()
-		if err := json.Unmarshal(r.Params[i], &concreteVal); err != nil {
+		if atomic.LoadInt32(&rejectDuplicateKeys) != 0 {
+			if key, ok := findDuplicateKey(r.Params[i]); ok {
+				fieldName := strings.ToLower(rt.Field(i).Name)
+				str := fmt.Sprintf("parameter #%d '%s' contains "+
+					"duplicate key %q", i+1, fieldName, key)
+				return nil, makeError(ErrInvalidParameter, str)
+			}
+		}
+		param := r.Params[i]
+		if atomic.LoadInt32(&lenientParams) != 0 {
+			param = integralParam(rvf.Type(), param)
+		}
+		param = amountParam(rt.Field(i), param)
+		if err := json.Unmarshal(param, &concreteVal); err != nil {
 			// The most common error is the wrong type, so
 			// explicitly detect that error and make it nicer.
 			fieldName := strings.ToLower(rt.Field(i).Name)
@@ -148,6 +922,16 @@ func UnmarshalCmd(r *Request) (interface{}, error) {
 				"unmarshal: %v", i+1, fieldName, err)
 			return nil, makeError(ErrInvalidType, str)
 		}
+
+		// Nullable parameters which are explicitly null use their
+		// associated default value as if they were omitted.
+		if rvf.Kind() == reflect.Ptr && rvf.IsNil() &&
+			isNullable(rt.Field(i)) {
+
+			if defaultVal, ok := info.defaults[i]; ok {
+				setDefault(rvf, defaultVal)
+			}
+		}
 	}
 
 	// When there are less supplied parameters than the total number of
@@ -157,9 +941,50 @@ func UnmarshalCmd(r *Request) (interface{}, error) {
 		populateDefaults(numParams, &info, rv)
 	}
 
+	// Reject ambiguous account names rather than guessing which account
+	// the client meant.
+	if err := checkAccountParams(r.Method, rv); err != nil {
+		return nil, err
+	}
+
+	// Ensure the parameters satisfy any requirements of the command beyond
+	// their types.
+	if v, ok := rvp.Interface().(paramsValidator); ok {
+		if err := v.validateParams(); err != nil {
+			return nil, err
+		}
+	}
+
 	return rvp.Interface(), nil
 }
 
+// CmdStreamDecoder reads a stream of JSON-RPC requests, such as newline
+// delimited requests received over a notification socket, and unmarshals each
+// of them into a suitable concrete command.  Only a single request is
+// buffered at a time, so the stream is never read into memory in full.
+type CmdStreamDecoder struct {
+	dec *json.Decoder
+}
+
+// NewCmdStreamDecoder returns a new decoder which reads JSON-RPC requests from
+// the passed reader.
+func NewCmdStreamDecoder(r io.Reader) *CmdStreamDecoder {
+	return &CmdStreamDecoder{dec: json.NewDecoder(r)}
+}
+
+// Next reads the next JSON-RPC request from the stream and unmarshals it into
+// a suitable concrete command via UnmarshalCmd.  Reads which return only part
+// of a request are continued until the full request is available.  io.EOF is
+// returned once the end of the stream is reached.
+func (d *CmdStreamDecoder) Next() (interface{}, error) {
+	var request Request
+	if err := d.dec.Decode(&request); err != nil {
+		return nil, err
+	}
+
+	return UnmarshalCmd(&request)
+}
+
 // isNumeric returns whether the passed reflect kind is a signed or unsigned
 // integer of any magnitude or a float of any magnitude.
 func isNumeric(kind reflect.Kind) bool {
@@ -508,6 +1333,9 @@ func assignField(paramNum int, fieldName string, dest reflect.Value, src reflect
 //   - Conversion from string to arrays, slices, structs, and maps by treating
 //     the string as marshalled JSON and calling json.Unmarshal into the
 //     destination field
+//
+// A nil argument for a field with a 'jsonrpcnullable' struct tag leaves the
+// optional field unset so later optional fields may be provided without it.
 func NewCmd(method string, args ...interface{}) (interface{}, error) {
 	// Look up details about the provided method.  Any methods that aren't
 	// registered are an error.
@@ -540,6 +1368,11 @@ func NewCmd(method string, args ...interface{}) (interface{}, error) {
 		// struct field.
 		rvf := rv.Field(i)
 		fieldName := strings.ToLower(rt.Field(i).Name)
+		if args[i] == nil && rvf.Kind() == reflect.Ptr &&
+			isNullable(rt.Field(i)) {
+
+			continue
+		}
 		err := assignField(i+1, fieldName, rvf, reflect.ValueOf(args[i]))
 		if err != nil {
 			return nil, err
@@ -6,9 +6,13 @@ package btcjson_test
 
 import (
 	"encoding/json"
+	"io"
 	"math"
 	"reflect"
+	"strings"
+	"sync"
 	"testing"
+	"testing/iotest"
 
 	"github.com/btcsuite/btcd/btcjson"
 )
@@ -367,13 +371,13 @@ func TestNewCmdErrors(t *testing.T) {
 			name:   "too few parameters to command with required + optional",
 			method: "getblock",
 			args:   []interface{}{},
-			err:    btcjson.Error{ErrorCode: btcjson.ErrNumParams},
+			err:    btcjson.Error{ErrorCode: btcjson.ErrTooFewParams},
 		},
 		{
 			name:   "too many parameters to command with no optional",
 			method: "getblockcount",
 			args:   []interface{}{"123"},
-			err:    btcjson.Error{ErrorCode: btcjson.ErrNumParams},
+			err:    btcjson.Error{ErrorCode: btcjson.ErrTooManyParams},
 		},
 		{
 			name:   "incorrect parameter type",
@@ -401,6 +405,69 @@ func TestNewCmdErrors(t *testing.T) {
 	}
 }
 
+// TestNumParamsErrorCodes ensures a wrong number of params is reported with
+// ErrTooFewParams or ErrTooManyParams rather than the deprecated ErrNumParams.
+// Callers which previously compared against ErrNumParams must check for both
+// of the specific codes instead, as is done here.
+func TestNumParamsErrorCodes(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		request btcjson.Request
+		code    btcjson.ErrorCode
+	}{
+		{
+			name: "too few params",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "getblock",
+				Params:  []json.RawMessage{},
+			},
+			code: btcjson.ErrTooFewParams,
+		},
+		{
+			name: "too many params",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "getblockcount",
+				Params:  []json.RawMessage{[]byte(`1`)},
+			},
+			code: btcjson.ErrTooManyParams,
+		},
+	}
+
+	t.Logf("Running %d tests", len(tests))
+	for i, test := range tests {
+		_, err := btcjson.UnmarshalCmd(&test.request)
+		jerr, ok := err.(btcjson.Error)
+		if !ok {
+			t.Errorf("Test #%d (%s) wrong error - got %T (%[3]v), "+
+				"want btcjson.Error", i, test.name, err)
+			continue
+		}
+		if jerr.ErrorCode == btcjson.ErrNumParams {
+			t.Errorf("Test #%d (%s) unexpected deprecated error "+
+				"code %v", i, test.name, jerr.ErrorCode)
+			continue
+		}
+		switch jerr.ErrorCode {
+		case btcjson.ErrTooFewParams, btcjson.ErrTooManyParams:
+		default:
+			t.Errorf("Test #%d (%s) error code %v is not a wrong "+
+				"number of params code", i, test.name,
+				jerr.ErrorCode)
+			continue
+		}
+		if jerr.ErrorCode != test.code {
+			t.Errorf("Test #%d (%s) mismatched error code - got "+
+				"%v, want %v", i, test.name, jerr.ErrorCode,
+				test.code)
+			continue
+		}
+	}
+}
+
 // TestMarshalCmdErrors  tests the error paths of the MarshalCmd function.
 func TestMarshalCmdErrors(t *testing.T) {
 	t.Parallel()
@@ -449,15 +516,817 @@ func TestMarshalCmdErrors(t *testing.T) {
 	}
 }
 
-// TestUnmarshalCmdErrors  tests the error paths of the UnmarshalCmd function.
-func TestUnmarshalCmdErrors(t *testing.T) {
+// TestMarshalCmdZeroNotifySpent ensures a notifyspent command without any
+// outpoints marshals without dereferencing a missing outpoint and unmarshals
+// back to a command without outpoints.
+func TestMarshalCmdZeroNotifySpent(t *testing.T) {
 	t.Parallel()
 
+	marshalled, err := btcjson.MarshalCmd(1, &btcjson.NotifySpentCmd{})
+	if err != nil {
+		t.Fatalf("MarshalCmd: unexpected error: %v", err)
+	}
+	const want = `{"jsonrpc":"1.0","method":"notifyspent","params":[null],"id":1}`
+	if string(marshalled) != want {
+		t.Fatalf("MarshalCmd: unexpected result - got %s, want %s",
+			marshalled, want)
+	}
+
+	var request btcjson.Request
+	if err := json.Unmarshal(marshalled, &request); err != nil {
+		t.Fatalf("json.Unmarshal: unexpected error: %v", err)
+	}
+	cmd, err := btcjson.UnmarshalCmd(&request)
+	if err != nil {
+		t.Fatalf("UnmarshalCmd: unexpected error: %v", err)
+	}
+	wantCmd := &btcjson.NotifySpentCmd{CheckHistory: btcjson.Bool(true)}
+	if !reflect.DeepEqual(cmd, wantCmd) {
+		t.Fatalf("UnmarshalCmd: unexpected command - got %+v, want %+v",
+			cmd, wantCmd)
+	}
+}
+
+// TestUnmarshalCmdAllocates ensures UnmarshalCmd allocates a new command, along
+// with its outpoints, for every request so commands parsed from the same
+// request do not share memory.
+func TestUnmarshalCmdAllocates(t *testing.T) {
+	t.Parallel()
+
+	request := btcjson.Request{
+		Jsonrpc: "1.0",
+		Method:  "notifyspent",
+		Params: []json.RawMessage{
+			[]byte(`[{"hash":"123","index":0}]`),
+		},
+	}
+	cmd1, err := btcjson.UnmarshalCmd(&request)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	cmd2, err := btcjson.UnmarshalCmd(&request)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	notifyCmd1 := cmd1.(*btcjson.NotifySpentCmd)
+	notifyCmd2 := cmd2.(*btcjson.NotifySpentCmd)
+	if notifyCmd1 == notifyCmd2 {
+		t.Fatal("commands parsed from the same request are identical")
+	}
+	if &notifyCmd1.OutPoints[0] == &notifyCmd2.OutPoints[0] {
+		t.Fatal("commands parsed from the same request share outpoints")
+	}
+	if notifyCmd1.CheckHistory == notifyCmd2.CheckHistory {
+		t.Fatal("commands parsed from the same request share defaults")
+	}
+
+	// Modifying one command must not be observable through the other.
+	notifyCmd1.OutPoints[0].Index = 1
+	*notifyCmd1.CheckHistory = false
+	if notifyCmd2.OutPoints[0].Index != 0 || !*notifyCmd2.CheckHistory {
+		t.Fatalf("modifying a command changed another - got %+v",
+			notifyCmd2)
+	}
+}
+
+// TestUnmarshalCmdLenientParams ensures UnmarshalCmd rejects unexpected
+// trailing params by default and ignores them when configured to be lenient.
+// It intentionally does not run in parallel since it modifies package-level
+// state.
+func TestUnmarshalCmdLenientParams(t *testing.T) {
+	defer btcjson.SetLenientParams(false)
+
 	tests := []struct {
 		name    string
+		lenient bool
+		method  string
+		params  []json.RawMessage
+		cmd     interface{}
+		err     error
+	}{
+		{
+			name:    "strict with extra trailing param",
+			lenient: false,
+			method:  "gettxout",
+			params: []json.RawMessage{[]byte(`"123"`), []byte(`1`),
+				[]byte(`true`), []byte(`"extra"`)},
+			err: btcjson.Error{ErrorCode: btcjson.ErrTooManyParams},
+		},
+		{
+			name:    "lenient with extra trailing param",
+			lenient: true,
+			method:  "gettxout",
+			params: []json.RawMessage{[]byte(`"123"`), []byte(`1`),
+				[]byte(`true`), []byte(`"extra"`)},
+			cmd: btcjson.NewGetTxOutCmd("123", 1, btcjson.Bool(true)),
+		},
+		{
+			name:    "lenient with several extra trailing params",
+			lenient: true,
+			method:  "gettxout",
+			params: []json.RawMessage{[]byte(`"123"`), []byte(`1`),
+				[]byte(`true`), []byte(`{}`), []byte(`[]`)},
+			cmd: btcjson.NewGetTxOutCmd("123", 1, btcjson.Bool(true)),
+		},
+		{
+			name:    "lenient still requires params",
+			lenient: true,
+			method:  "gettxout",
+			params:  []json.RawMessage{[]byte(`"123"`)},
+			err:     btcjson.Error{ErrorCode: btcjson.ErrTooFewParams},
+		},
+		{
+			name:    "lenient with no params",
+			lenient: true,
+			method:  "getblockcount",
+			params:  []json.RawMessage{[]byte(`1`)},
+			cmd:     btcjson.NewGetBlockCountCmd(),
+		},
+	}
+
+	t.Logf("Running %d tests", len(tests))
+	for i, test := range tests {
+		btcjson.SetLenientParams(test.lenient)
+		request := btcjson.Request{
+			Jsonrpc: "1.0",
+			Method:  test.method,
+			Params:  test.params,
+		}
+		cmd, err := btcjson.UnmarshalCmd(&request)
+		if reflect.TypeOf(err) != reflect.TypeOf(test.err) {
+			t.Errorf("Test #%d (%s) wrong error - got %T (%v), "+
+				"want %T", i, test.name, err, err, test.err)
+			continue
+		}
+		if terr, ok := test.err.(btcjson.Error); ok {
+			gotErrorCode := err.(btcjson.Error).ErrorCode
+			if gotErrorCode != terr.ErrorCode {
+				t.Errorf("Test #%d (%s) mismatched error code "+
+					"- got %v (%v), want %v", i, test.name,
+					gotErrorCode, err, terr.ErrorCode)
+			}
+			continue
+		}
+		if !reflect.DeepEqual(cmd, test.cmd) {
+			t.Errorf("Test #%d (%s) unexpected unmarshalled "+
+				"command - got %+v, want %+v", i, test.name,
+				cmd, test.cmd)
+		}
+	}
+}
+
+// TestUnmarshalCmdLimits ensures UnmarshalCmd enforces the default and
+// configured maximum number of addresses and passphrase lengths, and that zero
+// removes the limits.  It intentionally does not run in parallel since it modifies
+// package-level state.
+func TestUnmarshalCmdLimits(t *testing.T) {
+	defer btcjson.SetMaxAddresses(0)
+	defer btcjson.SetMaxPassphraseLen(btcjson.DefaultMaxPassphraseLen)
+	defer btcjson.SetMinPassphraseLen(1)
+
+	// Passphrases are limited to the default maximum length unless
+	// configured otherwise.
+	const defaultMax = btcjson.DefaultMaxPassphraseLen
+	if got := btcjson.MaxPassphraseLen(); got != defaultMax {
+		t.Fatalf("unexpected default max passphrase length - got %d, "+
+			"want %d", got, defaultMax)
+	}
+	for _, n := range []int{defaultMax, defaultMax + 1} {
+		passphrase, err := json.Marshal(strings.Repeat("a", n))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		_, err = btcjson.UnmarshalCmd(&btcjson.Request{
+			Jsonrpc: "1.0",
+			Method:  "walletpassphrase",
+			Params:  []json.RawMessage{passphrase, []byte(`60`)},
+		})
+		if wantErr := n > defaultMax; (err != nil) != wantErr {
+			t.Fatalf("unexpected result for %d byte passphrase "+
+				"- got %v, want error %v", n, err, wantErr)
+		}
+	}
+
+	const (
+		addr1 = "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"
+		addr2 = "3J98t1WpEZ73CNmQviecrnyiWrnqRhWNLy"
+	)
+	tests := []struct {
+		name          string
+		maxAddrs      int
+		minPassphrase int
+		maxPassphrase int
+		request       btcjson.Request
+		err           error
+	}{
+		{
+			name:     "addresses within limit",
+			maxAddrs: 2,
+			request: btcjson.Request{
+				Method: "loadtxfilter",
+				Params: []json.RawMessage{[]byte(`true`),
+					[]byte(`["` + addr1 + `"]`), []byte(`[]`)},
+			},
+		},
+		{
+			name:     "addresses at limit",
+			maxAddrs: 2,
+			request: btcjson.Request{
+				Method: "loadtxfilter",
+				Params: []json.RawMessage{[]byte(`true`),
+					[]byte(`["` + addr1 + `","` + addr2 + `"]`),
+					[]byte(`[]`)},
+			},
+		},
+		{
+			name:     "addresses over limit",
+			maxAddrs: 1,
+			request: btcjson.Request{
+				Method: "loadtxfilter",
+				Params: []json.RawMessage{[]byte(`true`),
+					[]byte(`["` + addr1 + `","` + addr2 + `"]`),
+					[]byte(`[]`)},
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name:     "notifywallet addresses over limit",
+			maxAddrs: 1,
+			request: btcjson.Request{
+				Method: "notifywallet",
+				Params: []json.RawMessage{
+					[]byte(`["` + addr1 + `","` + addr2 + `"]`),
+					[]byte(`[]`)},
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name: "addresses without limit",
+			request: btcjson.Request{
+				Method: "loadtxfilter",
+				Params: []json.RawMessage{[]byte(`true`),
+					[]byte(`["` + addr1 + `","` + addr2 + `"]`),
+					[]byte(`[]`)},
+			},
+		},
+		{
+			name:          "passphrase at limit",
+			maxPassphrase: 4,
+			request: btcjson.Request{
+				Method: "walletpassphrase",
+				Params: []json.RawMessage{[]byte(`"s3cr"`),
+					[]byte(`60`)},
+			},
+		},
+		{
+			name:          "passphrase over limit",
+			maxPassphrase: 3,
+			request: btcjson.Request{
+				Method: "walletpassphrase",
+				Params: []json.RawMessage{[]byte(`"s3cr"`),
+					[]byte(`60`)},
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name:          "new passphrase over limit",
+			maxPassphrase: 3,
+			request: btcjson.Request{
+				Method: "walletpassphrasechange",
+				Params: []json.RawMessage{[]byte(`"old"`),
+					[]byte(`"s3cr"`)},
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name:          "authenticate passphrase over limit",
+			maxPassphrase: 3,
+			request: btcjson.Request{
+				Method: "authenticate",
+				Params: []json.RawMessage{[]byte(`"user"`),
+					[]byte(`"s3cr"`)},
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name:          "empty new wallet passphrase",
+			minPassphrase: 1,
+			request: btcjson.Request{
+				Method: "createencryptedwallet",
+				Params: []json.RawMessage{[]byte(`""`)},
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name:          "new wallet passphrase under minimum",
+			minPassphrase: 5,
+			request: btcjson.Request{
+				Method: "createencryptedwallet",
+				Params: []json.RawMessage{[]byte(`"s3cr"`)},
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name:          "new wallet passphrase at minimum",
+			minPassphrase: 4,
+			request: btcjson.Request{
+				Method: "createencryptedwallet",
+				Params: []json.RawMessage{[]byte(`"s3cr"`)},
+			},
+		},
+		{
+			name:          "new wallet passphrase over limit",
+			minPassphrase: 1,
+			maxPassphrase: 3,
+			request: btcjson.Request{
+				Method: "createencryptedwallet",
+				Params: []json.RawMessage{[]byte(`"s3cr"`)},
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name: "empty new wallet passphrase without minimum",
+			request: btcjson.Request{
+				Method: "createencryptedwallet",
+				Params: []json.RawMessage{[]byte(`""`)},
+			},
+		},
+	}
+
+	t.Logf("Running %d tests", len(tests))
+	for i, test := range tests {
+		btcjson.SetMaxAddresses(test.maxAddrs)
+		btcjson.SetMinPassphraseLen(test.minPassphrase)
+		btcjson.SetMaxPassphraseLen(test.maxPassphrase)
+		if got := btcjson.MaxAddresses(); got != test.maxAddrs {
+			t.Errorf("Test #%d (%s) unexpected max addresses - "+
+				"got %d, want %d", i, test.name, got,
+				test.maxAddrs)
+			continue
+		}
+		if got := btcjson.MaxPassphraseLen(); got != test.maxPassphrase {
+			t.Errorf("Test #%d (%s) unexpected max passphrase "+
+				"length - got %d, want %d", i, test.name, got,
+				test.maxPassphrase)
+			continue
+		}
+
+		test.request.Jsonrpc = "1.0"
+		_, err := btcjson.UnmarshalCmd(&test.request)
+		if reflect.TypeOf(err) != reflect.TypeOf(test.err) {
+			t.Errorf("Test #%d (%s) wrong error - got %T (%v), "+
+				"want %T", i, test.name, err, err, test.err)
+			continue
+		}
+		if terr, ok := test.err.(btcjson.Error); ok {
+			gotErrorCode := err.(btcjson.Error).ErrorCode
+			if gotErrorCode != terr.ErrorCode {
+				t.Errorf("Test #%d (%s) mismatched error code "+
+					"- got %v (%v), want %v", i, test.name,
+					gotErrorCode, err, terr.ErrorCode)
+			}
+			if strings.Contains(err.Error(), "s3cr") {
+				t.Errorf("Test #%d (%s) error exposes the "+
+					"passphrase: %v", i, test.name, err)
+			}
+		}
+	}
+}
+
+// TestMaxAddressesConcurrent ensures the limits may be adjusted while commands
+// are concurrently being parsed.  It is primarily useful when run with the
+// race detector.  It intentionally does not run in parallel since it modifies
+// package-level state.
+func TestMaxAddressesConcurrent(t *testing.T) {
+	defer btcjson.SetMaxAddresses(0)
+	defer btcjson.SetMaxPassphraseLen(btcjson.DefaultMaxPassphraseLen)
+
+	request := btcjson.Request{
+		Jsonrpc: "1.0",
+		Method:  "loadtxfilter",
+		Params: []json.RawMessage{[]byte(`true`),
+			[]byte(`["1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"]`),
+			[]byte(`[]`)},
+	}
+
+	const numIterations = 100
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < numIterations; i++ {
+			btcjson.SetMaxAddresses(i % 3)
+			btcjson.SetMaxPassphraseLen(i % 3)
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for i := 0; i < numIterations; i++ {
+			if max := btcjson.MaxAddresses(); max < 0 || max > 2 {
+				t.Errorf("unexpected max addresses %d", max)
+				return
+			}
+
+			// The request only has a single address, so it is
+			// accepted regardless of the limit in effect.
+			if _, err := btcjson.UnmarshalCmd(&request); err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+		}
+	}()
+	wg.Wait()
+}
+
+// TestUnmarshalCmdDuplicateKeys ensures UnmarshalCmd accepts objects with
+// duplicate keys by default and rejects them when configured to.  It
+// intentionally does not run in parallel since it modifies package-level state.
+func TestUnmarshalCmdDuplicateKeys(t *testing.T) {
+	defer btcjson.SetRejectDuplicateKeys(false)
+
+	const addr = "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"
+	tests := []struct {
+		name    string
+		reject  bool
 		request btcjson.Request
-		err     btcjson.Error
+		cmd     interface{}
+		err     error
+	}{
+		{
+			name: "sendmany duplicate address keeps last amount",
+			request: btcjson.Request{
+				Method: "sendmany",
+				Params: []json.RawMessage{[]byte(`"acct"`),
+					[]byte(`{"` + addr + `":0.5,"` + addr + `":1}`)},
+			},
+			cmd: btcjson.NewSendManyCmd("acct",
+				map[string]float64{addr: 1}, btcjson.Int(1),
+				nil),
+		},
+		{
+			name:   "sendmany duplicate address rejected",
+			reject: true,
+			request: btcjson.Request{
+				Method: "sendmany",
+				Params: []json.RawMessage{[]byte(`"acct"`),
+					[]byte(`{"` + addr + `":0.5,"` + addr + `":1}`)},
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name:   "sendmany distinct addresses accepted",
+			reject: true,
+			request: btcjson.Request{
+				Method: "sendmany",
+				Params: []json.RawMessage{[]byte(`"acct"`),
+					[]byte(`{"` + addr + `":0.5}`)},
+			},
+			cmd: btcjson.NewSendManyCmd("acct",
+				map[string]float64{addr: 0.5}, btcjson.Int(1),
+				nil),
+		},
+		{
+			name:   "rescan duplicate outpoint key rejected",
+			reject: true,
+			request: btcjson.Request{
+				Method: "rescan",
+				Params: []json.RawMessage{[]byte(`"123"`),
+					[]byte(`[]`),
+					[]byte(`[{"hash":"123","index":0},` +
+						`{"hash":"456","index":0,"index":1}]`)},
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name:   "rescan duplicate integral exponent key rejected",
+			reject: true,
+			request: btcjson.Request{
+				Method: "rescan",
+				Params: []json.RawMessage{[]byte(`"123"`),
+					[]byte(`[]`),
+					[]byte(`[{"hash":"456","index":0,"index":1e0}]`)},
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name:   "rescan keys repeated across outpoints accepted",
+			reject: true,
+			request: btcjson.Request{
+				Method: "rescan",
+				Params: []json.RawMessage{[]byte(`"123"`),
+					[]byte(`[]`),
+					[]byte(`[{"hash":"123","index":0},` +
+						`{"hash":"456","index":1}]`)},
+			},
+			cmd: btcjson.NewRescanCmd("123", []string{},
+				[]btcjson.OutPoint{
+					{Hash: "123", Index: 0},
+					{Hash: "456", Index: 1},
+				}, nil),
+		},
+	}
+
+	t.Logf("Running %d tests", len(tests))
+	for i, test := range tests {
+		btcjson.SetRejectDuplicateKeys(test.reject)
+		test.request.Jsonrpc = "1.0"
+		cmd, err := btcjson.UnmarshalCmd(&test.request)
+		if reflect.TypeOf(err) != reflect.TypeOf(test.err) {
+			t.Errorf("Test #%d (%s) wrong error - got %T (%v), "+
+				"want %T", i, test.name, err, err, test.err)
+			continue
+		}
+		if terr, ok := test.err.(btcjson.Error); ok {
+			gotErrorCode := err.(btcjson.Error).ErrorCode
+			if gotErrorCode != terr.ErrorCode {
+				t.Errorf("Test #%d (%s) mismatched error code "+
+					"- got %v (%v), want %v", i, test.name,
+					gotErrorCode, err, terr.ErrorCode)
+			}
+			continue
+		}
+		if !reflect.DeepEqual(cmd, test.cmd) {
+			t.Errorf("Test #%d (%s) unexpected unmarshalled "+
+				"command - got %+v, want %+v", i, test.name,
+				cmd, test.cmd)
+		}
+	}
+}
+
+// TestMarshalCmdAmountsAsStrings ensures MarshalCmd marshals amounts as JSON
+// numbers by default and as fixed decimal strings when configured to, and that
+// both forms unmarshal to the same exact amounts.  It intentionally does not
+// run in parallel since it modifies package-level state.
+func TestMarshalCmdAmountsAsStrings(t *testing.T) {
+	defer btcjson.SetAmountsAsStrings(false)
+
+	const addr = "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"
+	tests := []struct {
+		name       string
+		cmd        interface{}
+		asStrings  bool
+		marshalled string
+	}{
+		{
+			name:       "sendtoaddress amount as number",
+			cmd:        btcjson.NewSendToAddressCmd(addr, 0.00000001, nil, nil),
+			marshalled: `{"jsonrpc":"1.0","method":"sendtoaddress","params":["` + addr + `",1e-8],"id":1}`,
+		},
+		{
+			name:       "sendtoaddress amount as string",
+			cmd:        btcjson.NewSendToAddressCmd(addr, 0.00000001, nil, nil),
+			asStrings:  true,
+			marshalled: `{"jsonrpc":"1.0","method":"sendtoaddress","params":["` + addr + `","0.00000001"],"id":1}`,
+		},
+		{
+			name: "sendmany amounts as strings",
+			cmd: btcjson.NewSendManyCmd("acct",
+				map[string]float64{addr: 21000000}, btcjson.Int(1), nil),
+			asStrings:  true,
+			marshalled: `{"jsonrpc":"1.0","method":"sendmany","params":["acct",{"` + addr + `":"21000000.00000000"},1],"id":1}`,
+		},
+		{
+			name: "sweepaccount optional fee rate as string",
+			cmd: btcjson.NewSweepAccountCmd("acct", addr, btcjson.Int(1),
+				btcjson.Float64(0.0001)),
+			asStrings:  true,
+			marshalled: `{"jsonrpc":"1.0","method":"sweepaccount","params":["acct","` + addr + `",1,"0.00010000"],"id":1}`,
+		},
+		{
+			name:       "non-amount params unchanged",
+			cmd:        btcjson.NewGetBlockHashCmd(1),
+			asStrings:  true,
+			marshalled: `{"jsonrpc":"1.0","method":"getblockhash","params":[1],"id":1}`,
+		},
+	}
+
+	t.Logf("Running %d tests", len(tests))
+	for i, test := range tests {
+		btcjson.SetAmountsAsStrings(test.asStrings)
+		marshalled, err := btcjson.MarshalCmd(1, test.cmd)
+		if err != nil {
+			t.Errorf("Test #%d (%s) unexpected error: %v", i,
+				test.name, err)
+			continue
+		}
+		if string(marshalled) != test.marshalled {
+			t.Errorf("Test #%d (%s) unexpected marshalled data - "+
+				"got %s, want %s", i, test.name, marshalled,
+				test.marshalled)
+			continue
+		}
+
+		var request btcjson.Request
+		if err := json.Unmarshal(marshalled, &request); err != nil {
+			t.Errorf("Test #%d (%s) unexpected error while "+
+				"unmarshalling JSON-RPC request: %v", i,
+				test.name, err)
+			continue
+		}
+		cmd, err := btcjson.UnmarshalCmd(&request)
+		if err != nil {
+			t.Errorf("Test #%d (%s) unexpected UnmarshalCmd "+
+				"error: %v", i, test.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(cmd, test.cmd) {
+			t.Errorf("Test #%d (%s) unexpected unmarshalled "+
+				"command - got %+v, want %+v", i, test.name,
+				cmd, test.cmd)
+			continue
+		}
+	}
+}
+
+// TestMarshalCmdOmitEmptyParams ensures MarshalCmd emits an empty params array
+// by default, omits it entirely when configured to, and that both forms
+// unmarshal to the same command.  It intentionally does not run in parallel
+// since it modifies package-level state.
+func TestMarshalCmdOmitEmptyParams(t *testing.T) {
+	defer btcjson.SetOmitEmptyParams(false)
+
+	tests := []struct {
+		name       string
+		cmd        interface{}
+		omit       bool
+		marshalled string
+	}{
+		{
+			name:       "getbalances with empty params",
+			cmd:        btcjson.NewGetBalancesCmd(),
+			marshalled: `{"jsonrpc":"1.0","method":"getbalances","params":[],"id":1}`,
+		},
+		{
+			name:       "getbalances without params",
+			cmd:        btcjson.NewGetBalancesCmd(),
+			omit:       true,
+			marshalled: `{"jsonrpc":"1.0","method":"getbalances","id":1}`,
+		},
+		{
+			name:       "getbestblock with empty params",
+			cmd:        btcjson.NewGetBestBlockCmd(),
+			marshalled: `{"jsonrpc":"1.0","method":"getbestblock","params":[],"id":1}`,
+		},
+		{
+			name:       "getbestblock without params",
+			cmd:        btcjson.NewGetBestBlockCmd(),
+			omit:       true,
+			marshalled: `{"jsonrpc":"1.0","method":"getbestblock","id":1}`,
+		},
+		{
+			name:       "getblockhash keeps params",
+			cmd:        btcjson.NewGetBlockHashCmd(1),
+			omit:       true,
+			marshalled: `{"jsonrpc":"1.0","method":"getblockhash","params":[1],"id":1}`,
+		},
+	}
+
+	t.Logf("Running %d tests", len(tests))
+	for i, test := range tests {
+		btcjson.SetOmitEmptyParams(test.omit)
+		marshalled, err := btcjson.MarshalCmd(1, test.cmd)
+		if err != nil {
+			t.Errorf("Test #%d (%s) unexpected error: %v", i,
+				test.name, err)
+			continue
+		}
+		if string(marshalled) != test.marshalled {
+			t.Errorf("Test #%d (%s) unexpected marshalled data - "+
+				"got %s, want %s", i, test.name, marshalled,
+				test.marshalled)
+			continue
+		}
+
+		var request btcjson.Request
+		if err := json.Unmarshal(marshalled, &request); err != nil {
+			t.Errorf("Test #%d (%s) unexpected error while "+
+				"unmarshalling JSON-RPC request: %v", i,
+				test.name, err)
+			continue
+		}
+		cmd, err := btcjson.UnmarshalCmd(&request)
+		if err != nil {
+			t.Errorf("Test #%d (%s) unexpected UnmarshalCmd "+
+				"error: %v", i, test.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(cmd, test.cmd) {
+			t.Errorf("Test #%d (%s) unexpected unmarshalled "+
+				"command - got %+v, want %+v", i, test.name,
+				cmd, test.cmd)
+			continue
+		}
+	}
+}
+
+// TestNullableParams ensures unset optional params are only marshalled as null
+// when they are marked nullable and a later param is set, and that only those
+// params unmarshal from null to their defaults.  A nil cmd only tests
+// unmarshalling.
+func TestNullableParams(t *testing.T) {
+	t.Parallel()
+
+	const (
+		hash = "000000000019d6689c085ae165831e934ff763ae46a2a6c172b3f1b60a8ce26f"
+		addr = "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"
+	)
+	tests := []struct {
+		name         string
+		cmd          interface{}
+		marshalled   string
+		unmarshalled interface{}
 	}{
+		{
+			name:       "verifychain unset params before timeout",
+			cmd:        btcjson.NewVerifyChainCmd(nil, nil).WithTimeout(60000),
+			marshalled: `{"jsonrpc":"1.0","method":"verifychain","params":[null,null,60000],"id":1}`,
+			unmarshalled: &btcjson.VerifyChainCmd{
+				CheckLevel:    btcjson.Int32(3),
+				CheckDepth:    btcjson.Int32(288),
+				TimeoutMillis: btcjson.Int(60000),
+			},
+		},
+		{
+			name: "rescan unset end block before timeout",
+			cmd: btcjson.NewRescanCmd(hash, []string{addr},
+				[]btcjson.OutPoint{}, nil).WithTimeout(5000),
+			marshalled: `{"jsonrpc":"1.0","method":"rescan","params":["` + hash + `",["` + addr + `"],[],null,5000],"id":1}`,
+			unmarshalled: &btcjson.RescanCmd{
+				BeginBlock:    hash,
+				Addresses:     []string{addr},
+				OutPoints:     []btcjson.OutPoint{},
+				TimeoutMillis: btcjson.Int(5000),
+			},
+		},
+		{
+			name: "listtransactions unset count ends params",
+			cmd: btcjson.NewListTransactionsCmd(btcjson.String("acct"),
+				nil, btcjson.Int(5), nil),
+			marshalled: `{"jsonrpc":"1.0","method":"listtransactions","params":["acct"],"id":1}`,
+			unmarshalled: &btcjson.ListTransactionsCmd{
+				Account:          btcjson.String("acct"),
+				Count:            btcjson.Int(10),
+				From:             btcjson.Int(0),
+				IncludeWatchOnly: btcjson.Bool(false),
+			},
+		},
+		{
+			name:       "listtransactions null count is not defaulted",
+			marshalled: `{"jsonrpc":"1.0","method":"listtransactions","params":["acct",null],"id":1}`,
+			unmarshalled: &btcjson.ListTransactionsCmd{
+				Account:          btcjson.String("acct"),
+				From:             btcjson.Int(0),
+				IncludeWatchOnly: btcjson.Bool(false),
+			},
+		},
+	}
+
+	t.Logf("Running %d tests", len(tests))
+	for i, test := range tests {
+		if test.cmd != nil {
+			marshalled, err := btcjson.MarshalCmd(1, test.cmd)
+			if err != nil {
+				t.Errorf("Test #%d (%s) unexpected error: %v", i,
+					test.name, err)
+				continue
+			}
+			if string(marshalled) != test.marshalled {
+				t.Errorf("Test #%d (%s) unexpected marshalled "+
+					"data - got %s, want %s", i, test.name,
+					marshalled, test.marshalled)
+				continue
+			}
+		}
+
+		var request btcjson.Request
+		err := json.Unmarshal([]byte(test.marshalled), &request)
+		if err != nil {
+			t.Errorf("Test #%d (%s) unexpected error while "+
+				"unmarshalling JSON-RPC request: %v", i,
+				test.name, err)
+			continue
+		}
+		cmd, err := btcjson.UnmarshalCmd(&request)
+		if err != nil {
+			t.Errorf("Test #%d (%s) unexpected UnmarshalCmd "+
+				"error: %v", i, test.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(cmd, test.unmarshalled) {
+			t.Errorf("Test #%d (%s) unexpected unmarshalled "+
+				"command - got %+v, want %+v", i, test.name,
+				cmd, test.unmarshalled)
+		}
+	}
+}
+
+// TestUnmarshalCmdErrors tests the generic error paths of the UnmarshalCmd
+// function.  Errors specific to a command are tested alongside the command.
+func TestUnmarshalCmdErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []unmarshalCmdErrorTest{
 		{
 			name: "unregistered type",
 			request: btcjson.Request{
@@ -476,7 +1345,29 @@ func TestUnmarshalCmdErrors(t *testing.T) {
 				Params:  []json.RawMessage{[]byte(`"bogusparam"`)},
 				ID:      nil,
 			},
-			err: btcjson.Error{ErrorCode: btcjson.ErrNumParams},
+			err: btcjson.Error{ErrorCode: btcjson.ErrTooManyParams},
+		},
+		{
+			name: "too few params for command with optional params",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "getblock",
+				Params:  []json.RawMessage{},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrTooFewParams},
+		},
+		{
+			name: "too many params for command with optional params",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "getblock",
+				Params: []json.RawMessage{[]byte(`"123"`),
+					[]byte(`true`), []byte(`false`),
+					[]byte(`"bogusparam"`)},
+				ID: nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrTooManyParams},
 		},
 		{
 			name: "invalid type for a parameter",
@@ -499,6 +1390,21 @@ func TestUnmarshalCmdErrors(t *testing.T) {
 			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidType},
 		},
 	}
+	testUnmarshalCmdErrors(t, tests)
+}
+
+// unmarshalCmdErrorTest describes a request UnmarshalCmd is expected to reject
+// along with the expected error.
+type unmarshalCmdErrorTest struct {
+	name    string
+	request btcjson.Request
+	err     btcjson.Error
+}
+
+// testUnmarshalCmdErrors ensures UnmarshalCmd rejects each of the passed
+// requests with the expected error code.
+func testUnmarshalCmdErrors(t *testing.T, tests []unmarshalCmdErrorTest) {
+	t.Helper()
 
 	t.Logf("Running %d tests", len(tests))
 	for i, test := range tests {
@@ -517,3 +1423,307 @@ func TestUnmarshalCmdErrors(t *testing.T) {
 		}
 	}
 }
+
+// TestUnmarshalCmdIntegralNumbers ensures integer parameters sent as numbers
+// with a fraction or exponent are accepted when parsing is lenient and their
+// value is integral, and rejected otherwise.  It intentionally does not run in
+// parallel since it modifies package-level state.
+func TestUnmarshalCmdIntegralNumbers(t *testing.T) {
+	defer btcjson.SetLenientParams(false)
+
+	tests := []struct {
+		name    string
+		request btcjson.Request
+		want    interface{}
+		err     *btcjson.Error
+	}{
+		{
+			name: "exponent height for getblockhash",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "getblockhash",
+				Params:  []json.RawMessage{[]byte(`1e3`)},
+			},
+			want: &btcjson.GetBlockHashCmd{Index: 1000},
+		},
+		{
+			name: "fraction height for getblockhash",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "getblockhash",
+				Params:  []json.RawMessage{[]byte(`1000.0`)},
+			},
+			want: &btcjson.GetBlockHashCmd{Index: 1000},
+		},
+		{
+			name: "exponent vout for gettxout",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "gettxout",
+				Params:  []json.RawMessage{[]byte(`"123"`), []byte(`1e3`)},
+			},
+			want: &btcjson.GetTxOutCmd{
+				Txid:           "123",
+				Vout:           1000,
+				IncludeMempool: btcjson.Bool(true),
+			},
+		},
+		{
+			name: "exponent with fraction for optional param",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "getbalance",
+				Params:  []json.RawMessage{[]byte(`"acct"`), []byte(`2.5E1`)},
+			},
+			want: &btcjson.GetBalanceCmd{
+				Account: btcjson.String("acct"),
+				MinConf: btcjson.Int(25),
+			},
+		},
+		{
+			name: "nested exponent vout for lockunspent",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "lockunspent",
+				Params: []json.RawMessage{[]byte(`true`),
+					[]byte(`[{"txid":"123","vout":1e3}]`)},
+			},
+			want: &btcjson.LockUnspentCmd{
+				Unlock: true,
+				Transactions: []btcjson.TransactionInput{
+					{Txid: "123", Vout: 1000},
+				},
+			},
+		},
+		{
+			name: "nested fraction vout for createrawtransaction",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "createrawtransaction",
+				Params: []json.RawMessage{
+					[]byte(`[{"txid":"123","vout":1.0},` +
+						`{"txid":"456","vout":2}]`),
+					[]byte(`{"1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2":0.5}`)},
+			},
+			want: &btcjson.CreateRawTransactionCmd{
+				Inputs: []btcjson.TransactionInput{
+					{Txid: "123", Vout: 1},
+					{Txid: "456", Vout: 2},
+				},
+				Amounts: map[string]float64{
+					"1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2": 0.5,
+				},
+			},
+		},
+		{
+			name: "nested exponent index for loadtxfilter",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "loadtxfilter",
+				Params: []json.RawMessage{[]byte(`false`),
+					[]byte(`[]`),
+					[]byte(`[{"hash":"123","index":1e3}]`)},
+			},
+			want: &btcjson.LoadTxFilterCmd{
+				Reload:    false,
+				Addresses: []string{},
+				OutPoints: []btcjson.OutPoint{
+					{Hash: "123", Index: 1000},
+				},
+			},
+		},
+		{
+			name: "fractional height for getblockhash",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "getblockhash",
+				Params:  []json.RawMessage{[]byte(`1.5e0`)},
+			},
+			err: &btcjson.Error{ErrorCode: btcjson.ErrInvalidType},
+		},
+		{
+			name: "overflowing vout for gettxout",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "gettxout",
+				Params:  []json.RawMessage{[]byte(`"123"`), []byte(`1e10`)},
+			},
+			err: &btcjson.Error{ErrorCode: btcjson.ErrInvalidType},
+		},
+		{
+			name: "negative vout for gettxout",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "gettxout",
+				Params:  []json.RawMessage{[]byte(`"123"`), []byte(`-1e0`)},
+			},
+			err: &btcjson.Error{ErrorCode: btcjson.ErrInvalidType},
+		},
+		{
+			name: "huge exponent for getblockhash",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "getblockhash",
+				Params:  []json.RawMessage{[]byte(`1e1000000000`)},
+			},
+			err: &btcjson.Error{ErrorCode: btcjson.ErrInvalidType},
+		},
+	}
+
+	t.Logf("Running %d tests", len(tests))
+	for i, test := range tests {
+		// Strict parsing rejects every number which is not written as a
+		// plain integer.
+		btcjson.SetLenientParams(false)
+		_, err := btcjson.UnmarshalCmd(&test.request)
+		jerr, ok := err.(btcjson.Error)
+		if !ok || jerr.ErrorCode != btcjson.ErrInvalidType {
+			t.Errorf("Test #%d (%s) unexpected strict error - got "+
+				"%v, want %v", i, test.name, err,
+				btcjson.ErrInvalidType)
+			continue
+		}
+
+		btcjson.SetLenientParams(true)
+		cmd, err := btcjson.UnmarshalCmd(&test.request)
+		if test.err != nil {
+			jerr, ok := err.(btcjson.Error)
+			if !ok || jerr.ErrorCode != test.err.ErrorCode {
+				t.Errorf("Test #%d (%s) unexpected error - "+
+					"got %v, want %v", i, test.name, err,
+					test.err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Test #%d (%s) unexpected error: %v", i,
+				test.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(cmd, test.want) {
+			t.Errorf("Test #%d (%s) unexpected command - got %+v, "+
+				"want %+v", i, test.name, cmd, test.want)
+			continue
+		}
+	}
+}
+
+// TestCheckAccountParam ensures ambiguous account names are rejected both
+// directly and when unmarshalling commands which take them, while commands
+// which do not take account names are unaffected.
+func TestCheckAccountParam(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		account string
+		valid   bool
+	}{
+		{name: "default account", account: "", valid: true},
+		{name: "wildcard", account: "*", valid: true},
+		{name: "named account", account: "acct", valid: true},
+		{name: "inner whitespace", account: "my acct", valid: true},
+		{name: "whitespace only", account: " \t ", valid: false},
+		{name: "padded wildcard", account: " * ", valid: false},
+		{name: "padded name", account: " my acct ", valid: false},
+	}
+
+	t.Logf("Running %d tests", len(tests))
+	for i, test := range tests {
+		err := btcjson.TstCheckAccountParam("account", test.account)
+		if (err == nil) != test.valid {
+			t.Errorf("Test #%d (%s) unexpected result - got %v, "+
+				"want valid %v", i, test.name, err, test.valid)
+			continue
+		}
+
+		// Ensure both required and optional account parameters are
+		// checked when unmarshalling.
+		param, err := json.Marshal(test.account)
+		if err != nil {
+			t.Errorf("Test #%d (%s) unexpected error: %v", i,
+				test.name, err)
+			continue
+		}
+		requests := []btcjson.Request{{
+			Jsonrpc: "1.0",
+			Method:  "getbalance",
+			Params:  []json.RawMessage{param},
+		}, {
+			Jsonrpc: "1.0",
+			Method:  "renameaccount",
+			Params:  []json.RawMessage{[]byte(`"acct"`), param},
+		}, {
+			Jsonrpc: "1.0",
+			Method:  "move",
+			Params: []json.RawMessage{param, []byte(`"acct"`),
+				[]byte("1")},
+		}}
+		for _, request := range requests {
+			_, err := btcjson.UnmarshalCmd(&request)
+			if test.valid {
+				if err != nil {
+					t.Errorf("Test #%d (%s) unexpected %s "+
+						"error: %v", i, test.name,
+						request.Method, err)
+				}
+				continue
+			}
+			if jerr, ok := err.(btcjson.Error); !ok ||
+				jerr.ErrorCode != btcjson.ErrInvalidParameter {
+
+				t.Errorf("Test #%d (%s) unexpected %s error - "+
+					"got %v, want ErrInvalidParameter", i,
+					test.name, request.Method, err)
+			}
+		}
+	}
+
+	// Ensure string params of commands which do not take account names
+	// are left alone even when padded.
+	request := btcjson.Request{
+		Jsonrpc: "1.0",
+		Method:  "help",
+		Params:  []json.RawMessage{[]byte(`" getbalance "`)},
+	}
+	if _, err := btcjson.UnmarshalCmd(&request); err != nil {
+		t.Fatalf("unexpected help error: %v", err)
+	}
+}
+
+// TestCmdStreamDecoder ensures a stream of concatenated requests is decoded
+// into the expected commands one at a time, even when the underlying reader
+// only returns partial reads, and that io.EOF is returned at the end of the
+// stream.
+func TestCmdStreamDecoder(t *testing.T) {
+	t.Parallel()
+
+	stream := `{"jsonrpc":"1.0","method":"getblockcount","params":[],"id":1}` + "\n" +
+		`{"jsonrpc":"1.0","method":"getblockhash","params":[123],"id":2}` + "\n" +
+		`{"jsonrpc":"1.0","method":"notifyblocks","params":[],"id":3}` +
+		`{"jsonrpc":"1.0","method":"notifyreceived","params":[["1Address"]],"id":4}`
+	expected := []interface{}{
+		&btcjson.GetBlockCountCmd{},
+		&btcjson.GetBlockHashCmd{Index: 123},
+		&btcjson.NotifyBlocksCmd{},
+		&btcjson.NotifyReceivedCmd{Addresses: []string{"1Address"}},
+	}
+
+	r := iotest.OneByteReader(strings.NewReader(stream))
+	dec := btcjson.NewCmdStreamDecoder(r)
+	for i, want := range expected {
+		cmd, err := dec.Next()
+		if err != nil {
+			t.Fatalf("Next #%d: unexpected error: %v", i, err)
+		}
+		if !reflect.DeepEqual(cmd, want) {
+			t.Fatalf("Next #%d: unexpected command - got %+v, "+
+				"want %+v", i, cmd, want)
+		}
+	}
+
+	if _, err := dec.Next(); err != io.EOF {
+		t.Fatalf("Next: unexpected error at end of stream - got %v, "+
+			"want %v", err, io.EOF)
+	}
+}
@@ -110,7 +110,8 @@ All registered commands are registered with flags that identify information such
 as whether the command applies to a chain server, wallet server, or is a
 notification along with the method name to use.  These flags can be obtained
 with the MethodUsageFlags flags, and the method can be obtained with the
-CmdMethod function.
+CmdMethod function.  The positional parameters of a command, in the same form
+they are marshalled, can be obtained with the CmdParams function.
 
 Help Generation
 
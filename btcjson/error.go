@@ -60,8 +60,25 @@ const (
 
 	// ErrNumParams inidcates the number of params supplied do not
 	// match the requirements of the associated command.
+	//
+	// NOTE: This is no longer returned by the package in favor of the more
+	// specific ErrTooFewParams and ErrTooManyParams.  Callers that checked
+	// for it must check for both of those codes instead.
 	ErrNumParams
 
+	// ErrInvalidParameter indicates a parameter has the required type, but
+	// its value does not satisfy the requirements of the associated
+	// command.
+	ErrInvalidParameter
+
+	// ErrTooFewParams indicates fewer params were supplied than are
+	// required by the associated command.
+	ErrTooFewParams
+
+	// ErrTooManyParams indicates more params were supplied than are
+	// accepted by the associated command.
+	ErrTooManyParams
+
 	// numErrorCodes is the maximum error code number used in tests.
 	numErrorCodes
 )
@@ -80,6 +97,9 @@ var errorCodeStrings = map[ErrorCode]string{
 	ErrUnregisteredMethod:   "ErrUnregisteredMethod",
 	ErrMissingDescription:   "ErrMissingDescription",
 	ErrNumParams:            "ErrNumParams",
+	ErrInvalidParameter:     "ErrInvalidParameter",
+	ErrTooFewParams:         "ErrTooFewParams",
+	ErrTooManyParams:        "ErrTooManyParams",
 }
 
 // String returns the ErrorCode as a human-readable name.
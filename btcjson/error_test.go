@@ -30,6 +30,9 @@ func TestErrorCodeStringer(t *testing.T) {
 		{btcjson.ErrUnregisteredMethod, "ErrUnregisteredMethod"},
 		{btcjson.ErrNumParams, "ErrNumParams"},
 		{btcjson.ErrMissingDescription, "ErrMissingDescription"},
+		{btcjson.ErrInvalidParameter, "ErrInvalidParameter"},
+		{btcjson.ErrTooFewParams, "ErrTooFewParams"},
+		{btcjson.ErrTooManyParams, "ErrTooManyParams"},
 		{0xffff, "Unknown ErrorCode (65535)"},
 	}
 
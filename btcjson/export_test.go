@@ -43,6 +43,10 @@ var TstArgHelp = argHelp
 // package.
 var TestMethodHelp = methodHelp
 
+// TstCheckAccountParam makes the internal checkAccountParam function available
+// to the test package.
+var TstCheckAccountParam = checkAccountParam
+
 // TstIsValidResultType makes the internal isValidResultType function available
 // to the test package.
 var TstIsValidResultType = isValidResultType
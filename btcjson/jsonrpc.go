@@ -7,6 +7,7 @@ package btcjson
 import (
 	"encoding/json"
 	"fmt"
+	"sync/atomic"
 )
 
 // RPCErrorCode represents an error code to be used as a part of an RPCError
@@ -60,6 +61,20 @@ func IsValidIDType(id interface{}) bool {
 	}
 }
 
+// lastID is the most recent id handed out by NextID.  It must only be accessed
+// atomically.
+var lastID int64
+
+// NextID returns the next id in a monotonically increasing sequence that is
+// shared by the entire process.  It is suitable for the id passed to MarshalCmd
+// when a caller needs to correlate replies to the requests which produced them,
+// such as when issuing many notifyspent commands.
+//
+// This function is safe for concurrent access.
+func NextID() int64 {
+	return atomic.AddInt64(&lastID, 1)
+}
+
 // Request is a type for raw JSON-RPC 1.0 requests.  The Method field identifies
 // the specific command type which in turns leads to different parameters.
 // Callers typically will not use this directly since this package provides a
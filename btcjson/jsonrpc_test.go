@@ -7,6 +7,7 @@ package btcjson_test
 import (
 	"encoding/json"
 	"reflect"
+	"sync"
 	"testing"
 
 	"github.com/btcsuite/btcd/btcjson"
@@ -53,6 +54,47 @@ func TestIsValidIDType(t *testing.T) {
 	}
 }
 
+// TestNextID ensures the NextID function hands out unique, increasing ids when
+// called concurrently.
+func TestNextID(t *testing.T) {
+	t.Parallel()
+
+	const numGoroutines = 8
+	const idsPerGoroutine = 1000
+
+	var wg sync.WaitGroup
+	results := make([][]int64, numGoroutines)
+	for i := 0; i < numGoroutines; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			ids := make([]int64, 0, idsPerGoroutine)
+			for j := 0; j < idsPerGoroutine; j++ {
+				ids = append(ids, btcjson.NextID())
+			}
+			results[i] = ids
+		}(i)
+	}
+	wg.Wait()
+
+	seen := make(map[int64]struct{}, numGoroutines*idsPerGoroutine)
+	for i, ids := range results {
+		for j, id := range ids {
+			if _, ok := seen[id]; ok {
+				t.Fatalf("goroutine #%d returned duplicate id %d",
+					i, id)
+			}
+			seen[id] = struct{}{}
+
+			// Each goroutine must observe its own ids increasing.
+			if j > 0 && id <= ids[j-1] {
+				t.Fatalf("goroutine #%d id %d is not greater "+
+					"than previous id %d", i, id, ids[j-1])
+			}
+		}
+	}
+}
+
 // TestMarshalResponse ensures the MarshalResponse function works as expected.
 func TestMarshalResponse(t *testing.T) {
 	t.Parallel()
@@ -149,6 +149,16 @@ func isAcceptableKind(kind reflect.Kind) bool {
 //     params
 //   - A field that has a 'jsonrpcdefault' struct tag must be an optional field
 //     (pointer)
+//   - A field with a 'jsonrpcamount:"true"' struct tag is an amount in BTC.
+//     It is accepted as either a number or a decimal string and is marshalled
+//     as a fixed decimal string when configured with SetAmountsAsStrings.  It
+//     must be a float64, *float64, or map[string]float64
+//   - A field with a 'jsonrpcomitdefault:"true"' struct tag is marshalled as
+//     though it were unset when it is set to its 'jsonrpcdefault' value
+//   - An optional field with a 'jsonrpcnullable:"true"' struct tag is
+//     marshalled as null when it is unset and a later optional field is set,
+//     and unmarshals from null to its default value.  Any other unset optional
+//     field ends the marshalled params
 //
 // NOTE: This function only needs to be able to examine the structure of the
 // passed struct, so it does not need to be an actual instance.  Therefore, it
@@ -269,6 +279,10 @@ func RegisterCmd(method string, cmd interface{}, flags UsageFlag) error {
 // MustRegisterCmd performs the same function as RegisterCmd except it panics
 // if there is an error.  This should only be called from package init
 // functions.
+//
+// Since every command in this package is registered from an init function,
+// registering a custom command with a method that collides with one of them,
+// or with another custom command, panics with a message naming the method.
 func MustRegisterCmd(method string, cmd interface{}, flags UsageFlag) {
 	if err := RegisterCmd(method, cmd, flags); err != nil {
 		panic(fmt.Sprintf("failed to register type %q: %v\n", method,
@@ -5,8 +5,10 @@
 package btcjson_test
 
 import (
+	"fmt"
 	"reflect"
 	"sort"
+	"strings"
 	"testing"
 
 	"github.com/btcsuite/btcd/btcjson"
@@ -242,6 +244,30 @@ func TestMustRegisterCmdPanic(t *testing.T) {
 	btcjson.MustRegisterCmd("panicme", 0, 0)
 }
 
+// TestMustRegisterCmdDuplicatePanic ensures the MustRegisterCmd function
+// panics with a message naming the method when used to register a method which
+// is already registered.
+func TestMustRegisterCmdDuplicatePanic(t *testing.T) {
+	t.Parallel()
+
+	// Setup a defer to catch the expected panic and ensure it names the
+	// duplicate method.
+	defer func() {
+		err := recover()
+		if err == nil {
+			t.Fatal("MustRegisterCmd did not panic as expected")
+		}
+		if !strings.Contains(fmt.Sprint(err), `"getblock"`) {
+			t.Errorf("MustRegisterCmd panic does not name the "+
+				"duplicate method: %v", err)
+		}
+	}()
+
+	// Intentionally try to register a method which is already registered
+	// by the package to force a panic.
+	btcjson.MustRegisterCmd("getblock", (*btcjson.GetBlockCmd)(nil), 0)
+}
+
 // TestRegisteredCmdMethods tests the RegisteredCmdMethods function ensure it
 // works as expected.
 func TestRegisteredCmdMethods(t *testing.T) {
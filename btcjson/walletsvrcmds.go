@@ -7,6 +7,8 @@
 
 package btcjson
 
+import "fmt"
+
 // AddMultisigAddressCmd defines the addmutisigaddress JSON-RPC command.
 type AddMultisigAddressCmd struct {
 	NRequired int
@@ -27,6 +29,28 @@ func NewAddMultisigAddressCmd(nRequired int, keys []string, account *string) *Ad
 	}
 }
 
+// validateParams ensures the number of required signatures is positive and no
+// more than the number of keys, and that each key is either a hex-encoded
+// public key or a valid address.
+func (c *AddMultisigAddressCmd) validateParams() error {
+	if c.NRequired < 1 || c.NRequired > len(c.Keys) {
+		str := fmt.Sprintf("parameter 'nrequired' must be between 1 "+
+			"and the number of keys %d (got %d)", len(c.Keys),
+			c.NRequired)
+		return makeError(ErrInvalidParameter, str)
+	}
+	for i, key := range c.Keys {
+		if checkAddressParam("keys", key) == nil {
+			continue
+		}
+		err := checkPubKeyParam(fmt.Sprintf("keys[%d]", i), key)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // AddWitnessAddressCmd defines the addwitnessaddress JSON-RPC command.
 type AddWitnessAddressCmd struct {
 	Address string
@@ -81,6 +105,11 @@ func NewEncryptWalletCmd(passphrase string) *EncryptWalletCmd {
 	}
 }
 
+// validateParams ensures the passphrase is within the maximum length.
+func (c *EncryptWalletCmd) validateParams() error {
+	return checkPassphraseParam("passphrase", c.Passphrase)
+}
+
 // EstimateFeeCmd defines the estimatefee JSON-RPC command.
 type EstimateFeeCmd struct {
 	NumBlocks int64
@@ -94,6 +123,16 @@ func NewEstimateFeeCmd(numBlocks int64) *EstimateFeeCmd {
 	}
 }
 
+// validateParams ensures the target number of blocks is positive.
+func (c *EstimateFeeCmd) validateParams() error {
+	if c.NumBlocks < 1 {
+		str := fmt.Sprintf("parameter 'numblocks' must be positive "+
+			"(got %d)", c.NumBlocks)
+		return makeError(ErrInvalidParameter, str)
+	}
+	return nil
+}
+
 // EstimatePriorityCmd defines the estimatepriority JSON-RPC command.
 type EstimatePriorityCmd struct {
 	NumBlocks int64
@@ -107,6 +146,16 @@ func NewEstimatePriorityCmd(numBlocks int64) *EstimatePriorityCmd {
 	}
 }
 
+// validateParams ensures the target number of blocks is positive.
+func (c *EstimatePriorityCmd) validateParams() error {
+	if c.NumBlocks < 1 {
+		str := fmt.Sprintf("parameter 'numblocks' must be positive "+
+			"(got %d)", c.NumBlocks)
+		return makeError(ErrInvalidParameter, str)
+	}
+	return nil
+}
+
 // GetAccountCmd defines the getaccount JSON-RPC command.
 type GetAccountCmd struct {
 	Address string
@@ -164,9 +213,35 @@ func NewGetBalanceCmd(account *string, minConf *int) *GetBalanceCmd {
 	}
 }
 
-// GetNewAddressCmd defines the getnewaddress JSON-RPC command.
+// GetBalancesCmd defines the getbalances JSON-RPC command.
+type GetBalancesCmd struct{}
+
+// NewGetBalancesCmd returns a new instance which can be used to issue a
+// getbalances JSON-RPC command.
+func NewGetBalancesCmd() *GetBalancesCmd {
+	return &GetBalancesCmd{}
+}
+
+// Address types that may be requested with the getnewaddress command.
+const (
+	// AddressTypeLegacy is a pay-to-pubkey-hash address.
+	AddressTypeLegacy = "legacy"
+
+	// AddressTypeP2SHSegwit is a pay-to-witness-pubkey-hash address nested
+	// in a pay-to-script-hash address.
+	AddressTypeP2SHSegwit = "p2sh-segwit"
+
+	// AddressTypeBech32 is a native segwit pay-to-witness-pubkey-hash
+	// address.
+	AddressTypeBech32 = "bech32"
+)
+
+// GetNewAddressCmd defines the getnewaddress JSON-RPC command.  The address
+// type is omitted when marshalling a command which requests the default legacy
+// type for compatibility with servers that do not support it.
 type GetNewAddressCmd struct {
-	Account *string
+	Account     *string `jsonrpcnullable:"true"`
+	AddressType *string `jsonrpcdefault:"\"legacy\"" jsonrpcomitdefault:"true"`
 }
 
 // NewGetNewAddressCmd returns a new instance which can be used to issue a
@@ -180,6 +255,28 @@ func NewGetNewAddressCmd(account *string) *GetNewAddressCmd {
 	}
 }
 
+// WithAddressType sets the type of address to request and returns the command
+// so calls may be chained.
+func (c *GetNewAddressCmd) WithAddressType(addressType string) *GetNewAddressCmd {
+	c.AddressType = &addressType
+	return c
+}
+
+// validateParams ensures the address type is one of the supported types.
+func (c *GetNewAddressCmd) validateParams() error {
+	if c.AddressType == nil {
+		return nil
+	}
+	switch *c.AddressType {
+	case AddressTypeLegacy, AddressTypeP2SHSegwit, AddressTypeBech32:
+		return nil
+	}
+	str := fmt.Sprintf("parameter 'address_type' must be one of %q, %q, "+
+		"or %q (got %q)", AddressTypeLegacy, AddressTypeP2SHSegwit,
+		AddressTypeBech32, *c.AddressType)
+	return makeError(ErrInvalidParameter, str)
+}
+
 // GetRawChangeAddressCmd defines the getrawchangeaddress JSON-RPC command.
 type GetRawChangeAddressCmd struct {
 	Account *string
@@ -349,6 +446,11 @@ func NewListReceivedByAccountCmd(minConf *int, includeEmpty, includeWatchOnly *b
 	}
 }
 
+// validateParams ensures the minimum number of confirmations is not negative.
+func (c *ListReceivedByAccountCmd) validateParams() error {
+	return checkMinConfParam(c.MinConf)
+}
+
 // ListReceivedByAddressCmd defines the listreceivedbyaddress JSON-RPC command.
 type ListReceivedByAddressCmd struct {
 	MinConf          *int  `jsonrpcdefault:"1"`
@@ -369,6 +471,22 @@ func NewListReceivedByAddressCmd(minConf *int, includeEmpty, includeWatchOnly *b
 	}
 }
 
+// validateParams ensures the minimum number of confirmations is not negative.
+func (c *ListReceivedByAddressCmd) validateParams() error {
+	return checkMinConfParam(c.MinConf)
+}
+
+// checkMinConfParam returns an error when the passed optional minimum number
+// of confirmations is negative.
+func checkMinConfParam(minConf *int) error {
+	if minConf != nil && *minConf < 0 {
+		str := fmt.Sprintf("parameter 'minconf' must not be negative "+
+			"(got %d)", *minConf)
+		return makeError(ErrInvalidParameter, str)
+	}
+	return nil
+}
+
 // ListSinceBlockCmd defines the listsinceblock JSON-RPC command.
 type ListSinceBlockCmd struct {
 	BlockHash           *string
@@ -411,6 +529,21 @@ func NewListTransactionsCmd(account *string, count, from *int, includeWatchOnly
 	}
 }
 
+// validateParams ensures the count is positive and from is not negative.
+func (c *ListTransactionsCmd) validateParams() error {
+	if c.Count != nil && *c.Count < 1 {
+		str := fmt.Sprintf("parameter 'count' must be positive "+
+			"(got %d)", *c.Count)
+		return makeError(ErrInvalidParameter, str)
+	}
+	if c.From != nil && *c.From < 0 {
+		str := fmt.Sprintf("parameter 'from' must not be negative "+
+			"(got %d)", *c.From)
+		return makeError(ErrInvalidParameter, str)
+	}
+	return nil
+}
+
 // ListUnspentCmd defines the listunspent JSON-RPC command.
 type ListUnspentCmd struct {
 	MinConf   *int `jsonrpcdefault:"1"`
@@ -450,7 +583,7 @@ func NewLockUnspentCmd(unlock bool, transactions []TransactionInput) *LockUnspen
 type MoveCmd struct {
 	FromAccount string
 	ToAccount   string
-	Amount      float64 // In BTC
+	Amount      float64 `jsonrpcamount:"true"` // In BTC
 	MinConf     *int    `jsonrpcdefault:"1"`
 	Comment     *string
 }
@@ -474,7 +607,7 @@ func NewMoveCmd(fromAccount, toAccount string, amount float64, minConf *int, com
 type SendFromCmd struct {
 	FromAccount string
 	ToAddress   string
-	Amount      float64 // In BTC
+	Amount      float64 `jsonrpcamount:"true"` // In BTC
 	MinConf     *int    `jsonrpcdefault:"1"`
 	Comment     *string
 	CommentTo   *string
@@ -499,7 +632,7 @@ func NewSendFromCmd(fromAccount, toAddress string, amount float64, minConf *int,
 // SendManyCmd defines the sendmany JSON-RPC command.
 type SendManyCmd struct {
 	FromAccount string
-	Amounts     map[string]float64 `jsonrpcusage:"{\"address\":amount,...}"` // In BTC
+	Amounts     map[string]float64 `jsonrpcusage:"{\"address\":amount,...}" jsonrpcamount:"true"` // In BTC
 	MinConf     *int               `jsonrpcdefault:"1"`
 	Comment     *string
 }
@@ -521,7 +654,7 @@ func NewSendManyCmd(fromAccount string, amounts map[string]float64, minConf *int
 // SendToAddressCmd defines the sendtoaddress JSON-RPC command.
 type SendToAddressCmd struct {
 	Address   string
-	Amount    float64
+	Amount    float64 `jsonrpcamount:"true"`
 	Comment   *string
 	CommentTo *string
 }
@@ -557,7 +690,7 @@ func NewSetAccountCmd(address, account string) *SetAccountCmd {
 
 // SetTxFeeCmd defines the settxfee JSON-RPC command.
 type SetTxFeeCmd struct {
-	Amount float64 // In BTC
+	Amount float64 `jsonrpcamount:"true"` // In BTC
 }
 
 // NewSetTxFeeCmd returns a new instance which can be used to issue a settxfee
@@ -638,6 +771,11 @@ func NewWalletPassphraseCmd(passphrase string, timeout int64) *WalletPassphraseC
 	}
 }
 
+// validateParams ensures the passphrase is within the maximum length.
+func (c *WalletPassphraseCmd) validateParams() error {
+	return checkPassphraseParam("passphrase", c.Passphrase)
+}
+
 // WalletPassphraseChangeCmd defines the walletpassphrase JSON-RPC command.
 type WalletPassphraseChangeCmd struct {
 	OldPassphrase string
@@ -653,6 +791,15 @@ func NewWalletPassphraseChangeCmd(oldPassphrase, newPassphrase string) *WalletPa
 	}
 }
 
+// validateParams ensures both passphrases are within the maximum length.
+func (c *WalletPassphraseChangeCmd) validateParams() error {
+	err := checkPassphraseParam("oldpassphrase", c.OldPassphrase)
+	if err != nil {
+		return err
+	}
+	return checkPassphraseParam("newpassphrase", c.NewPassphrase)
+}
+
 func init() {
 	// The commands in this file are only usable with a wallet server.
 	flags := UFWalletOnly
@@ -668,6 +815,7 @@ func init() {
 	MustRegisterCmd("getaccountaddress", (*GetAccountAddressCmd)(nil), flags)
 	MustRegisterCmd("getaddressesbyaccount", (*GetAddressesByAccountCmd)(nil), flags)
 	MustRegisterCmd("getbalance", (*GetBalanceCmd)(nil), flags)
+	MustRegisterCmd("getbalances", (*GetBalancesCmd)(nil), flags)
 	MustRegisterCmd("getnewaddress", (*GetNewAddressCmd)(nil), flags)
 	MustRegisterCmd("getrawchangeaddress", (*GetRawChangeAddressCmd)(nil), flags)
 	MustRegisterCmd("getreceivedbyaccount", (*GetReceivedByAccountCmd)(nil), flags)
@@ -32,32 +32,32 @@ func TestWalletSvrCmds(t *testing.T) {
 		{
 			name: "addmultisigaddress",
 			newCmd: func() (interface{}, error) {
-				return btcjson.NewCmd("addmultisigaddress", 2, []string{"031234", "035678"})
+				return btcjson.NewCmd("addmultisigaddress", 2, []string{testPubKey, testPubKeyUncompressed})
 			},
 			staticCmd: func() interface{} {
-				keys := []string{"031234", "035678"}
+				keys := []string{testPubKey, testPubKeyUncompressed}
 				return btcjson.NewAddMultisigAddressCmd(2, keys, nil)
 			},
-			marshalled: `{"jsonrpc":"1.0","method":"addmultisigaddress","params":[2,["031234","035678"]],"id":1}`,
+			marshalled: `{"jsonrpc":"1.0","method":"addmultisigaddress","params":[2,["` + testPubKey + `","` + testPubKeyUncompressed + `"]],"id":1}`,
 			unmarshalled: &btcjson.AddMultisigAddressCmd{
 				NRequired: 2,
-				Keys:      []string{"031234", "035678"},
+				Keys:      []string{testPubKey, testPubKeyUncompressed},
 				Account:   nil,
 			},
 		},
 		{
 			name: "addmultisigaddress optional",
 			newCmd: func() (interface{}, error) {
-				return btcjson.NewCmd("addmultisigaddress", 2, []string{"031234", "035678"}, "test")
+				return btcjson.NewCmd("addmultisigaddress", 2, []string{testPubKey, testPubKeyUncompressed}, "test")
 			},
 			staticCmd: func() interface{} {
-				keys := []string{"031234", "035678"}
+				keys := []string{testPubKey, testPubKeyUncompressed}
 				return btcjson.NewAddMultisigAddressCmd(2, keys, btcjson.String("test"))
 			},
-			marshalled: `{"jsonrpc":"1.0","method":"addmultisigaddress","params":[2,["031234","035678"],"test"],"id":1}`,
+			marshalled: `{"jsonrpc":"1.0","method":"addmultisigaddress","params":[2,["` + testPubKey + `","` + testPubKeyUncompressed + `"],"test"],"id":1}`,
 			unmarshalled: &btcjson.AddMultisigAddressCmd{
 				NRequired: 2,
-				Keys:      []string{"031234", "035678"},
+				Keys:      []string{testPubKey, testPubKeyUncompressed},
 				Account:   btcjson.String("test"),
 			},
 		},
@@ -222,6 +222,17 @@ func TestWalletSvrCmds(t *testing.T) {
 				MinConf: btcjson.Int(6),
 			},
 		},
+		{
+			name: "getbalances",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("getbalances")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewGetBalancesCmd()
+			},
+			marshalled:   `{"jsonrpc":"1.0","method":"getbalances","params":[],"id":1}`,
+			unmarshalled: &btcjson.GetBalancesCmd{},
+		},
 		{
 			name: "getnewaddress",
 			newCmd: func() (interface{}, error) {
@@ -232,7 +243,8 @@ func TestWalletSvrCmds(t *testing.T) {
 			},
 			marshalled: `{"jsonrpc":"1.0","method":"getnewaddress","params":[],"id":1}`,
 			unmarshalled: &btcjson.GetNewAddressCmd{
-				Account: nil,
+				Account:     nil,
+				AddressType: btcjson.String("legacy"),
 			},
 		},
 		{
@@ -245,7 +257,50 @@ func TestWalletSvrCmds(t *testing.T) {
 			},
 			marshalled: `{"jsonrpc":"1.0","method":"getnewaddress","params":["acct"],"id":1}`,
 			unmarshalled: &btcjson.GetNewAddressCmd{
-				Account: btcjson.String("acct"),
+				Account:     btcjson.String("acct"),
+				AddressType: btcjson.String("legacy"),
+			},
+		},
+		{
+			name: "getnewaddress legacy omitted",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("getnewaddress", "acct", "legacy")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewGetNewAddressCmd(btcjson.String("acct")).WithAddressType("legacy")
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getnewaddress","params":["acct"],"id":1}`,
+			unmarshalled: &btcjson.GetNewAddressCmd{
+				Account:     btcjson.String("acct"),
+				AddressType: btcjson.String("legacy"),
+			},
+		},
+		{
+			name: "getnewaddress p2sh-segwit",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("getnewaddress", "acct", "p2sh-segwit")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewGetNewAddressCmd(btcjson.String("acct")).WithAddressType("p2sh-segwit")
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getnewaddress","params":["acct","p2sh-segwit"],"id":1}`,
+			unmarshalled: &btcjson.GetNewAddressCmd{
+				Account:     btcjson.String("acct"),
+				AddressType: btcjson.String("p2sh-segwit"),
+			},
+		},
+		{
+			name: "getnewaddress bech32 default account",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("getnewaddress", nil, "bech32")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewGetNewAddressCmd(nil).WithAddressType("bech32")
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getnewaddress","params":[null,"bech32"],"id":1}`,
+			unmarshalled: &btcjson.GetNewAddressCmd{
+				Account:     nil,
+				AddressType: btcjson.String("bech32"),
 			},
 		},
 		{
@@ -1224,6 +1279,13 @@ func TestWalletSvrCmds(t *testing.T) {
 			continue
 		}
 
+		// Ensure the params reported for the command match the
+		// marshalled params.
+		if err := checkCmdParams(test.staticCmd(), marshalled); err != nil {
+			t.Errorf("Test #%d (%s) %v", i, test.name, err)
+			continue
+		}
+
 		// Ensure the command is created without error via the generic
 		// new command creation function.
 		cmd, err := test.newCmd()
@@ -1272,3 +1334,173 @@ func TestWalletSvrCmds(t *testing.T) {
 		}
 	}
 }
+
+// TestWalletSvrCmdParamErrors ensures UnmarshalCmd rejects wallet server
+// commands whose parameters do not satisfy the requirements of the command.
+func TestWalletSvrCmdParamErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []unmarshalCmdErrorTest{
+		{
+			name: "zero nrequired for addmultisigaddress",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "addmultisigaddress",
+				Params: []json.RawMessage{[]byte(`0`),
+					[]byte(`["022afc20bf379bc96a2f4e9e63ffceb8652b2b6a097f63fbee6ecec2a49a48010e","1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"]`)},
+				ID: nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name: "nrequired above keys for addmultisigaddress",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "addmultisigaddress",
+				Params: []json.RawMessage{[]byte(`3`),
+					[]byte(`["022afc20bf379bc96a2f4e9e63ffceb8652b2b6a097f63fbee6ecec2a49a48010e","1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"]`)},
+				ID: nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name: "invalid key for addmultisigaddress",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "addmultisigaddress",
+				Params: []json.RawMessage{[]byte(`1`),
+					[]byte(`["031234"]`)},
+				ID: nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name: "negative minconf for listreceivedbyaccount",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "listreceivedbyaccount",
+				Params:  []json.RawMessage{[]byte(`-1`)},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name: "negative minconf for listreceivedbyaddress",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "listreceivedbyaddress",
+				Params:  []json.RawMessage{[]byte(`-1`)},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name: "zero count for listtransactions",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "listtransactions",
+				Params: []json.RawMessage{[]byte(`"acct"`),
+					[]byte(`0`)},
+				ID: nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name: "negative count for listtransactions",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "listtransactions",
+				Params: []json.RawMessage{[]byte(`"acct"`),
+					[]byte(`-1`)},
+				ID: nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name: "negative from for listtransactions",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "listtransactions",
+				Params: []json.RawMessage{[]byte(`"acct"`),
+					[]byte(`10`), []byte(`-1`)},
+				ID: nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name: "zero target for estimatefee",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "estimatefee",
+				Params:  []json.RawMessage{[]byte(`0`)},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name: "negative target for estimatefee",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "estimatefee",
+				Params:  []json.RawMessage{[]byte(`-1`)},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name: "zero target for estimatepriority",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "estimatepriority",
+				Params:  []json.RawMessage{[]byte(`0`)},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name: "negative target for estimatepriority",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "estimatepriority",
+				Params:  []json.RawMessage{[]byte(`-1`)},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name: "non-numeric string amount for sendtoaddress",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "sendtoaddress",
+				Params: []json.RawMessage{
+					[]byte(`"1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"`),
+					[]byte(`"0x10"`)},
+				ID: nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidType},
+		},
+		{
+			name: "non-numeric string amount for sendmany",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "sendmany",
+				Params: []json.RawMessage{[]byte(`"acct"`),
+					[]byte(`{"1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2":"NaN"}`)},
+				ID: nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidType},
+		},
+		{
+			name: "getnewaddress unsupported address type",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "getnewaddress",
+				Params: []json.RawMessage{[]byte(`"acct"`),
+					[]byte(`"p2wsh"`)},
+				ID: nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+	}
+	testUnmarshalCmdErrors(t, tests)
+}
@@ -4,6 +4,63 @@
 
 package btcjson
 
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+)
+
+// BalanceDetailsResult models the details data from the getbalances command.
+type BalanceDetailsResult struct {
+	Trusted          float64  `json:"trusted"`
+	UntrustedPending float64  `json:"untrusted_pending"`
+	Immature         float64  `json:"immature"`
+	Used             *float64 `json:"used,omitempty"`
+}
+
+// BumpFeeResult models the data returned by the bumpfee command.  Fees are in
+// BTC, and Errors describes any problems which did not prevent the replacement
+// transaction from being created.
+type BumpFeeResult struct {
+	TxID    string   `json:"txid"`
+	OrigFee float64  `json:"origfee"`
+	Fee     float64  `json:"fee"`
+	Errors  []string `json:"errors"`
+}
+
+// FundRawTransactionResult models the data returned by the fundrawtransaction
+// command.  ChangePosition is the index of the added change output, or -1 when
+// no change output was added.
+type FundRawTransactionResult struct {
+	Hex            string  `json:"hex"`
+	Fee            float64 `json:"fee"`
+	ChangePosition int     `json:"changepos"`
+}
+
+// GetAccountInfoResult models the data returned by the getaccountinfo command.
+// Balances are in BTC.
+type GetAccountInfoResult struct {
+	Account      string  `json:"account"`
+	Balance      float64 `json:"balance"`
+	Unconfirmed  float64 `json:"unconfirmed"`
+	AddressCount int     `json:"addresscount"`
+	NextAddress  string  `json:"nextaddress"`
+}
+
+// GetBalancesResult models the data returned from the getbalances command.
+//
+// Mine holds the spendable (trusted), unconfirmed (untrusted_pending) and
+// immature coinbase balances of the wallet.  WatchOnly is only set when the
+// wallet contains watch-only addresses.
+type GetBalancesResult struct {
+	Mine      BalanceDetailsResult  `json:"mine"`
+	WatchOnly *BalanceDetailsResult `json:"watchonly,omitempty"`
+}
+
 // GetTransactionDetailsResult models the details data from the gettransaction command.
 //
 // This models the "short" version of the ListTransactionsResult type, which
@@ -56,6 +113,13 @@ type InfoWalletResult struct {
 	Errors          string  `json:"errors"`
 }
 
+// ImportMultiResult models the data returned for each request of the
+// importmulti command.  Error is only set when the request failed.
+type ImportMultiResult struct {
+	Success bool      `json:"success"`
+	Error   *RPCError `json:"error,omitempty"`
+}
+
 // ListTransactionsResult models the data from the listtransactions command.
 type ListTransactionsResult struct {
 	Abandoned         bool     `json:"abandoned"`
@@ -100,13 +164,88 @@ type ListReceivedByAddressResult struct {
 	InvolvesWatchonly bool     `json:"involvesWatchonly,omitempty"`
 }
 
+// ListScriptsResult models the data returned for each imported redeem script
+// by the listscripts command.
+type ListScriptsResult struct {
+	Hash    string `json:"hash"`
+	Address string `json:"address"`
+	Script  string `json:"script"`
+}
+
+// UnmarshalJSON unmarshals a listscripts result entry and ensures the hash and
+// script are hex-encoded and the script is not empty.
+func (r *ListScriptsResult) UnmarshalJSON(data []byte) error {
+	// Use a distinct type without this method to avoid infinite recursion.
+	type scriptResult ListScriptsResult
+	var result scriptResult
+	if err := json.Unmarshal(data, &result); err != nil {
+		return err
+	}
+
+	if _, err := hex.DecodeString(result.Hash); err != nil {
+		return fmt.Errorf("listscripts result has an invalid hash: %v",
+			err)
+	}
+	if result.Script == "" {
+		return errors.New("listscripts result is missing the script " +
+			"field")
+	}
+	if _, err := hex.DecodeString(result.Script); err != nil {
+		return fmt.Errorf("listscripts result has an invalid script: %v",
+			err)
+	}
+
+	*r = ListScriptsResult(result)
+	return nil
+}
+
 // ListSinceBlockResult models the data from the listsinceblock command.
 type ListSinceBlockResult struct {
 	Transactions []ListTransactionsResult `json:"transactions"`
 	LastBlock    string                   `json:"lastblock"`
 }
 
+// UnmarshalJSON unmarshals the listsinceblock result and ensures the
+// transactions array is present, every transaction identifies itself by a
+// valid hash, and the last block is a valid hash.
+func (r *ListSinceBlockResult) UnmarshalJSON(data []byte) error {
+	// Use a distinct type without this method to avoid infinite recursion.
+	type sinceBlockResult ListSinceBlockResult
+	var result sinceBlockResult
+	if err := json.Unmarshal(data, &result); err != nil {
+		return err
+	}
+
+	if result.Transactions == nil {
+		return errors.New("listsinceblock result is missing the " +
+			"transactions field")
+	}
+	for i, tx := range result.Transactions {
+		if tx.TxID == "" {
+			return fmt.Errorf("listsinceblock result transaction %d "+
+				"is missing its txid", i)
+		}
+		if _, err := chainhash.NewHashFromStr(tx.TxID); err != nil {
+			return fmt.Errorf("listsinceblock result transaction %d "+
+				"has an invalid txid: %v", i, err)
+		}
+	}
+	if result.LastBlock == "" {
+		return errors.New("listsinceblock result is missing the " +
+			"lastblock field")
+	}
+	if _, err := chainhash.NewHashFromStr(result.LastBlock); err != nil {
+		return fmt.Errorf("listsinceblock result has an invalid "+
+			"lastblock: %v", err)
+	}
+
+	*r = ListSinceBlockResult(result)
+	return nil
+}
+
 // ListUnspentResult models a successful response from the listunspent request.
+// Spendable is false for watch-only outputs, while Solvable reports whether the
+// wallet knows how to spend the output if it had the private keys.
 type ListUnspentResult struct {
 	TxID          string  `json:"txid"`
 	Vout          uint32  `json:"vout"`
@@ -117,6 +256,7 @@ type ListUnspentResult struct {
 	Amount        float64 `json:"amount"`
 	Confirmations int64   `json:"confirmations"`
 	Spendable     bool    `json:"spendable"`
+	Solvable      bool    `json:"solvable"`
 }
 
 // SignRawTransactionError models the data that contains script verification
@@ -159,3 +299,84 @@ type GetBestBlockResult struct {
 	Hash   string `json:"hash"`
 	Height int32  `json:"height"`
 }
+
+// UnmarshalJSON unmarshals the getbestblock result.  Some servers encode the
+// height as a floating point number, so any integral value that fits in an
+// int32 is accepted.  An error is returned when the hash is missing.
+func (r *GetBestBlockResult) UnmarshalJSON(data []byte) error {
+	var reply struct {
+		Hash   *string  `json:"hash"`
+		Height *float64 `json:"height"`
+	}
+	if err := json.Unmarshal(data, &reply); err != nil {
+		return err
+	}
+	if reply.Hash == nil {
+		return errors.New("getbestblock result is missing the hash field")
+	}
+	if reply.Height == nil {
+		return errors.New("getbestblock result is missing the height " +
+			"field")
+	}
+	height := *reply.Height
+	if height != math.Trunc(height) || height < math.MinInt32 ||
+		height > math.MaxInt32 {
+
+		return fmt.Errorf("getbestblock result height %v is not a "+
+			"valid block height", height)
+	}
+
+	*r = GetBestBlockResult{
+		Hash:   *reply.Hash,
+		Height: int32(height),
+	}
+	return nil
+}
+
+// GetHDKeypoolInfoResult models the data from the gethdkeypoolinfo command.
+// HDSeedID is only set for HD wallets and UnlockedUntil is only set for
+// encrypted wallets.
+type GetHDKeypoolInfoResult struct {
+	HDSeedID              *string `json:"hdseedid,omitempty"`
+	KeypoolSize           int64   `json:"keypoolsize"`
+	KeypoolSizeHDInternal int64   `json:"keypoolsize_hd_internal"`
+	UnlockedUntil         *int64  `json:"unlocked_until,omitempty"`
+}
+
+// UnmarshalJSON unmarshals the gethdkeypoolinfo result and ensures the seed id,
+// when present, is a hex-encoded hash160 and both keypool sizes are present
+// and not negative.
+func (r *GetHDKeypoolInfoResult) UnmarshalJSON(data []byte) error {
+	var reply struct {
+		HDSeedID              *string `json:"hdseedid"`
+		KeypoolSize           *int64  `json:"keypoolsize"`
+		KeypoolSizeHDInternal *int64  `json:"keypoolsize_hd_internal"`
+		UnlockedUntil         *int64  `json:"unlocked_until"`
+	}
+	if err := json.Unmarshal(data, &reply); err != nil {
+		return err
+	}
+	if reply.HDSeedID != nil {
+		seedID, err := hex.DecodeString(*reply.HDSeedID)
+		if err != nil || len(seedID) != 20 {
+			return fmt.Errorf("gethdkeypoolinfo result hdseedid %q "+
+				"is not a hex-encoded hash160", *reply.HDSeedID)
+		}
+	}
+	if reply.KeypoolSize == nil || *reply.KeypoolSize < 0 {
+		return errors.New("gethdkeypoolinfo result is missing a valid " +
+			"keypoolsize field")
+	}
+	if reply.KeypoolSizeHDInternal == nil || *reply.KeypoolSizeHDInternal < 0 {
+		return errors.New("gethdkeypoolinfo result is missing a valid " +
+			"keypoolsize_hd_internal field")
+	}
+
+	*r = GetHDKeypoolInfoResult{
+		HDSeedID:              reply.HDSeedID,
+		KeypoolSize:           *reply.KeypoolSize,
+		KeypoolSizeHDInternal: *reply.KeypoolSizeHDInternal,
+		UnlockedUntil:         reply.UnlockedUntil,
+	}
+	return nil
+}
@@ -0,0 +1,297 @@
+// Copyright (c) 2014 The btcsuite developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package btcjson_test
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcjson"
+)
+
+// TestGetBestBlockResult ensures the getbestblock result decodes regardless of
+// key order, tolerates an integral height encoded as a float, and rejects
+// replies that are missing the hash or carry an invalid height.
+func TestGetBestBlockResult(t *testing.T) {
+	t.Parallel()
+
+	const hash = "000000000019d6689c085ae165831e934ff763ae46a2a6c172b3f1b60a8ce26f"
+	tests := []resultDecodeTest{
+		{
+			name:   "hash first",
+			result: `{"hash":"` + hash + `","height":100}`,
+			expected: btcjson.GetBestBlockResult{
+				Hash:   hash,
+				Height: 100,
+			},
+		},
+		{
+			name:   "height first",
+			result: `{"height":100,"hash":"` + hash + `"}`,
+			expected: btcjson.GetBestBlockResult{
+				Hash:   hash,
+				Height: 100,
+			},
+		},
+		{
+			name:   "float height",
+			result: `{"hash":"` + hash + `","height":100.0}`,
+			expected: btcjson.GetBestBlockResult{
+				Hash:   hash,
+				Height: 100,
+			},
+		},
+		{
+			name:    "missing hash",
+			result:  `{"height":100}`,
+			wantErr: true,
+		},
+		{
+			name:    "missing height",
+			result:  `{"hash":"` + hash + `"}`,
+			wantErr: true,
+		},
+		{
+			name:    "fractional height",
+			result:  `{"hash":"` + hash + `","height":100.5}`,
+			wantErr: true,
+		},
+		{
+			name:    "height out of range",
+			result:  `{"hash":"` + hash + `","height":4294967296}`,
+			wantErr: true,
+		},
+		{
+			name:    "non-string hash",
+			result:  `{"hash":1,"height":100}`,
+			wantErr: true,
+		},
+	}
+
+	testResultDecode(t, tests, func() interface{} {
+		return new(btcjson.GetBestBlockResult)
+	})
+}
+
+// TestListSinceBlockResult ensures the listsinceblock result decodes the
+// transactions and last block and rejects replies with a missing or invalid
+// transactions array or last block hash.
+func TestListSinceBlockResult(t *testing.T) {
+	t.Parallel()
+
+	const (
+		txid1     = "4a5e1e4baab89f3a32518a88c31bc87f618f76673e2cc77ab2127b7afdeda33b"
+		txid2     = "0e3e2357e806b6cdb1f70b54c3a3a17b6714ee1f0e68bebb44a74b1efd512098"
+		lastBlock = "000000006a625f06636b8bb6ac7b960a8d03705d1ace08b1a19da3fdcc99ddbd"
+	)
+	tests := []resultDecodeTest{
+		{
+			name: "two transactions",
+			result: `{"transactions":[` +
+				`{"account":"","address":"1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2",` +
+				`"amount":0.5,"category":"receive","confirmations":6,` +
+				`"time":1231469665,"timereceived":1231469665,"trusted":true,` +
+				`"txid":"` + txid1 + `","vout":0,"walletconflicts":[]},` +
+				`{"account":"","amount":-0.25,"category":"send",` +
+				`"confirmations":1,"fee":-0.0001,"time":1231469744,` +
+				`"timereceived":1231469744,"trusted":true,` +
+				`"txid":"` + txid2 + `","vout":1,"walletconflicts":[]}],` +
+				`"lastblock":"` + lastBlock + `"}`,
+			expected: btcjson.ListSinceBlockResult{
+				Transactions: []btcjson.ListTransactionsResult{
+					{
+						Address:         "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2",
+						Amount:          0.5,
+						Category:        "receive",
+						Confirmations:   6,
+						Time:            1231469665,
+						TimeReceived:    1231469665,
+						Trusted:         true,
+						TxID:            txid1,
+						WalletConflicts: []string{},
+					},
+					{
+						Amount:          -0.25,
+						Category:        "send",
+						Confirmations:   1,
+						Fee:             btcjson.Float64(-0.0001),
+						Time:            1231469744,
+						TimeReceived:    1231469744,
+						Trusted:         true,
+						TxID:            txid2,
+						Vout:            1,
+						WalletConflicts: []string{},
+					},
+				},
+				LastBlock: lastBlock,
+			},
+		},
+		{
+			name:   "no transactions",
+			result: `{"transactions":[],"lastblock":"` + lastBlock + `"}`,
+			expected: btcjson.ListSinceBlockResult{
+				Transactions: []btcjson.ListTransactionsResult{},
+				LastBlock:    lastBlock,
+			},
+		},
+		{
+			name:    "missing transactions",
+			result:  `{"lastblock":"` + lastBlock + `"}`,
+			wantErr: true,
+		},
+		{
+			name:    "transactions not an array",
+			result:  `{"transactions":{},"lastblock":"` + lastBlock + `"}`,
+			wantErr: true,
+		},
+		{
+			name:    "transaction missing txid",
+			result:  `{"transactions":[{"category":"send"}],"lastblock":"` + lastBlock + `"}`,
+			wantErr: true,
+		},
+		{
+			name:    "transaction with invalid txid",
+			result:  `{"transactions":[{"txid":"nothex"}],"lastblock":"` + lastBlock + `"}`,
+			wantErr: true,
+		},
+		{
+			name:    "missing lastblock",
+			result:  `{"transactions":[]}`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid lastblock",
+			result:  `{"transactions":[],"lastblock":"zz"}`,
+			wantErr: true,
+		},
+	}
+
+	testResultDecode(t, tests, func() interface{} {
+		return new(btcjson.ListSinceBlockResult)
+	})
+}
+
+// TestListScriptsResult ensures the listscripts result decodes an array of
+// imported redeem scripts and rejects entries with an invalid hash or script.
+func TestListScriptsResult(t *testing.T) {
+	t.Parallel()
+
+	const (
+		script1 = "5121022afc20bf379bc96a2f4e9e63ffceb8652b2b6a097f63fbee6ecec2a49a48010e51ae"
+		script2 = "0014751e76e8199196d454941c45d1b3a323f1433bd6"
+	)
+	tests := []resultDecodeTest{
+		{
+			name: "two scripts",
+			result: `[{"hash":"c7a8b7e2f7c1f5e1bd9b05d9bb8c3fb4f4d1d7c2",` +
+				`"address":"3J98t1WpEZ73CNmQviecrnyiWrnqRhWNLy",` +
+				`"script":"` + script1 + `"},` +
+				`{"hash":"8c9c0d0bc7c6bbeee3d0f9f0d0bb7e0a1bd9f0a2",` +
+				`"address":"3KF9nXowQ4asSGxRRzeiTpDjMuwM2nypAN",` +
+				`"script":"` + script2 + `"}]`,
+			expected: []btcjson.ListScriptsResult{
+				{
+					Hash:    "c7a8b7e2f7c1f5e1bd9b05d9bb8c3fb4f4d1d7c2",
+					Address: "3J98t1WpEZ73CNmQviecrnyiWrnqRhWNLy",
+					Script:  script1,
+				},
+				{
+					Hash:    "8c9c0d0bc7c6bbeee3d0f9f0d0bb7e0a1bd9f0a2",
+					Address: "3KF9nXowQ4asSGxRRzeiTpDjMuwM2nypAN",
+					Script:  script2,
+				},
+			},
+		},
+		{
+			name:     "no scripts",
+			result:   `[]`,
+			expected: []btcjson.ListScriptsResult{},
+		},
+		{
+			name:    "invalid hash",
+			result:  `[{"hash":"xyz","address":"","script":"` + script2 + `"}]`,
+			wantErr: true,
+		},
+		{
+			name:    "missing script",
+			result:  `[{"hash":"00","address":""}]`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid script",
+			result:  `[{"hash":"00","address":"","script":"0g"}]`,
+			wantErr: true,
+		},
+		{
+			name:    "not an array",
+			result:  `{"hash":"00","address":"","script":"51"}`,
+			wantErr: true,
+		},
+	}
+
+	testResultDecode(t, tests, func() interface{} {
+		return new([]btcjson.ListScriptsResult)
+	})
+}
+
+// TestGetHDKeypoolInfoResult ensures the gethdkeypoolinfo result decodes with
+// and without the seed id and unlock time and rejects replies with an invalid
+// seed id or a missing or invalid keypool size.
+func TestGetHDKeypoolInfoResult(t *testing.T) {
+	t.Parallel()
+
+	const seedID = "751e76e8199196d454941c45d1b3a323f1433bd6"
+	tests := []resultDecodeTest{
+		{
+			name: "unencrypted wallet",
+			result: `{"hdseedid":"` + seedID + `","keypoolsize":1000,` +
+				`"keypoolsize_hd_internal":500}`,
+			expected: btcjson.GetHDKeypoolInfoResult{
+				HDSeedID:              btcjson.String(seedID),
+				KeypoolSize:           1000,
+				KeypoolSizeHDInternal: 500,
+			},
+		},
+		{
+			name: "encrypted wallet",
+			result: `{"hdseedid":"` + seedID + `","keypoolsize":1000,` +
+				`"keypoolsize_hd_internal":500,"unlocked_until":1231469665}`,
+			expected: btcjson.GetHDKeypoolInfoResult{
+				HDSeedID:              btcjson.String(seedID),
+				KeypoolSize:           1000,
+				KeypoolSizeHDInternal: 500,
+				UnlockedUntil:         btcjson.Int64(1231469665),
+			},
+		},
+		{
+			name:   "non-HD wallet",
+			result: `{"keypoolsize":1000,"keypoolsize_hd_internal":0}`,
+			expected: btcjson.GetHDKeypoolInfoResult{
+				KeypoolSize: 1000,
+			},
+		},
+		{
+			name: "hdseedid wrong length",
+			result: `{"hdseedid":"751e76e8","keypoolsize":1000,` +
+				`"keypoolsize_hd_internal":500}`,
+			wantErr: true,
+		},
+		{
+			name: "missing keypoolsize",
+			result: `{"hdseedid":"` + seedID + `",` +
+				`"keypoolsize_hd_internal":500}`,
+			wantErr: true,
+		},
+		{
+			name: "negative keypoolsize_hd_internal",
+			result: `{"hdseedid":"` + seedID + `","keypoolsize":1000,` +
+				`"keypoolsize_hd_internal":-1}`,
+			wantErr: true,
+		},
+	}
+
+	testResultDecode(t, tests, func() interface{} {
+		return new(btcjson.GetHDKeypoolInfoResult)
+	})
+}
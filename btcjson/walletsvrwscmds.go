@@ -4,9 +4,91 @@
 
 package btcjson
 
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/btcsuite/btcutil"
+)
+
 // NOTE: This file is intended to house the RPC commands that are supported by
 // a wallet server, but are only available via websockets.
 
+// AbandonTransactionCmd defines the abandontransaction JSON-RPC command.  It
+// marks an unconfirmed transaction, and all of its in-wallet descendants, as
+// abandoned so their inputs may be spent again.
+type AbandonTransactionCmd struct {
+	TxID string
+}
+
+// NewAbandonTransactionCmd returns a new instance which can be used to issue an
+// abandontransaction JSON-RPC command.
+func NewAbandonTransactionCmd(txID string) *AbandonTransactionCmd {
+	return &AbandonTransactionCmd{
+		TxID: txID,
+	}
+}
+
+// validateParams ensures the transaction id is a valid hash.
+func (c *AbandonTransactionCmd) validateParams() error {
+	return checkHashParam("txid", c.TxID)
+}
+
+// BumpFeeOpts defines the optional settings of the bumpfee JSON-RPC command.
+// ConfTarget and TotalFee are mutually exclusive ways to choose the new fee.
+type BumpFeeOpts struct {
+	ConfTarget  *int   `json:"confTarget,omitempty"`
+	TotalFee    *int64 `json:"totalFee,omitempty"`
+	Replaceable *bool  `json:"replaceable,omitempty"`
+}
+
+// BumpFeeCmd defines the bumpfee JSON-RPC command.  It replaces an unconfirmed
+// wallet transaction which signals replaceability with one paying a higher
+// fee.
+type BumpFeeCmd struct {
+	TxID    string
+	Options *BumpFeeOpts
+}
+
+// NewBumpFeeCmd returns a new instance which can be used to issue a bumpfee
+// JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewBumpFeeCmd(txID string, options *BumpFeeOpts) *BumpFeeCmd {
+	return &BumpFeeCmd{
+		TxID:    txID,
+		Options: options,
+	}
+}
+
+// validateParams ensures the transaction id is a valid hash and, when provided,
+// that only one of the confirmation target and total fee is set and that it is
+// positive.
+func (c *BumpFeeCmd) validateParams() error {
+	if err := checkHashParam("txid", c.TxID); err != nil {
+		return err
+	}
+	if c.Options == nil {
+		return nil
+	}
+	if c.Options.ConfTarget != nil && c.Options.TotalFee != nil {
+		return makeError(ErrInvalidParameter, "parameters "+
+			"'confTarget' and 'totalFee' are mutually exclusive")
+	}
+	if c.Options.ConfTarget != nil && *c.Options.ConfTarget < 1 {
+		str := fmt.Sprintf("parameter 'confTarget' must be positive "+
+			"(got %d)", *c.Options.ConfTarget)
+		return makeError(ErrInvalidParameter, str)
+	}
+	if c.Options.TotalFee != nil && *c.Options.TotalFee < 1 {
+		str := fmt.Sprintf("parameter 'totalFee' must be positive "+
+			"(got %d)", *c.Options.TotalFee)
+		return makeError(ErrInvalidParameter, str)
+	}
+	return nil
+}
+
 // CreateEncryptedWalletCmd defines the createencryptedwallet JSON-RPC command.
 type CreateEncryptedWalletCmd struct {
 	Passphrase string
@@ -20,6 +102,12 @@ func NewCreateEncryptedWalletCmd(passphrase string) *CreateEncryptedWalletCmd {
 	}
 }
 
+// validateParams ensures the passphrase is within the minimum and maximum
+// lengths.
+func (c *CreateEncryptedWalletCmd) validateParams() error {
+	return checkNewPassphraseParam("passphrase", c.Passphrase)
+}
+
 // ExportWatchingWalletCmd defines the exportwatchingwallet JSON-RPC command.
 type ExportWatchingWalletCmd struct {
 	Account  *string
@@ -38,6 +126,91 @@ func NewExportWatchingWalletCmd(account *string, download *bool) *ExportWatching
 	}
 }
 
+// FundRawTransactionOpts defines the optional settings of the
+// fundrawtransaction JSON-RPC command.  Unset fields use the wallet defaults.
+type FundRawTransactionOpts struct {
+	ChangeAddress   *string  `json:"changeAddress,omitempty"`
+	FeeRate         *float64 `json:"feeRate,omitempty"`
+	IncludeWatching *bool    `json:"includeWatching,omitempty"`
+}
+
+// FundRawTransactionCmd defines the fundrawtransaction JSON-RPC command.  It
+// adds inputs, and a change output when needed, to the hex-encoded transaction
+// so that it pays for its outputs and fee.
+type FundRawTransactionCmd struct {
+	HexTx   string
+	Options *FundRawTransactionOpts
+}
+
+// NewFundRawTransactionCmd returns a new instance which can be used to issue a
+// fundrawtransaction JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewFundRawTransactionCmd(hexTx string, options *FundRawTransactionOpts) *FundRawTransactionCmd {
+	return &FundRawTransactionCmd{
+		HexTx:   hexTx,
+		Options: options,
+	}
+}
+
+// validateParams ensures the transaction is valid and, when provided, that the
+// change address is valid and the fee rate is positive.
+func (c *FundRawTransactionCmd) validateParams() error {
+	if err := checkTxParam("hextx", c.HexTx); err != nil {
+		return err
+	}
+	if c.Options == nil {
+		return nil
+	}
+	if c.Options.ChangeAddress != nil {
+		err := checkAddressParam("changeAddress", *c.Options.ChangeAddress)
+		if err != nil {
+			return err
+		}
+	}
+	if c.Options.FeeRate != nil && !(*c.Options.FeeRate > 0) {
+		str := fmt.Sprintf("parameter 'feeRate' must be positive "+
+			"(got %v)", *c.Options.FeeRate)
+		return makeError(ErrInvalidParameter, str)
+	}
+	return nil
+}
+
+// GetAccountInfoCmd defines the getaccountinfo JSON-RPC command.  It returns
+// the balances and next receiving address of an account in a single round
+// trip.
+type GetAccountInfoCmd struct {
+	Account string
+}
+
+// NewGetAccountInfoCmd returns a new instance which can be used to issue a
+// getaccountinfo JSON-RPC command.
+func NewGetAccountInfoCmd(account string) *GetAccountInfoCmd {
+	return &GetAccountInfoCmd{
+		Account: account,
+	}
+}
+
+// validateParams ensures the account names a single account rather than all of
+// them.
+func (c *GetAccountInfoCmd) validateParams() error {
+	if c.Account == "*" {
+		return makeError(ErrInvalidParameter, "parameter 'account' "+
+			"must name a single account")
+	}
+	return nil
+}
+
+// GetHDKeypoolInfoCmd defines the gethdkeypoolinfo JSON-RPC command.
+type GetHDKeypoolInfoCmd struct{}
+
+// NewGetHDKeypoolInfoCmd returns a new instance which can be used to issue a
+// gethdkeypoolinfo JSON-RPC command.
+func NewGetHDKeypoolInfoCmd() *GetHDKeypoolInfoCmd {
+	return &GetHDKeypoolInfoCmd{}
+}
+
 // GetUnconfirmedBalanceCmd defines the getunconfirmedbalance JSON-RPC command.
 type GetUnconfirmedBalanceCmd struct {
 	Account *string
@@ -54,6 +227,214 @@ func NewGetUnconfirmedBalanceCmd(account *string) *GetUnconfirmedBalanceCmd {
 	}
 }
 
+// ImportMultiScriptPubKey identifies the output script of an importmulti
+// request either by the hex-encoded script itself or by an address.  It is
+// marshalled to and from JSON as a string when it holds a script and as an
+// object with an address field when it holds an address.
+type ImportMultiScriptPubKey struct {
+	Script  string
+	Address string
+}
+
+// importMultiAddress is the JSON form of an ImportMultiScriptPubKey which
+// holds an address.
+type importMultiAddress struct {
+	Address string `json:"address"`
+}
+
+// MarshalJSON provides a custom Marshal method for ImportMultiScriptPubKey.
+func (s ImportMultiScriptPubKey) MarshalJSON() ([]byte, error) {
+	if s.Address != "" {
+		return json.Marshal(importMultiAddress{Address: s.Address})
+	}
+	return json.Marshal(s.Script)
+}
+
+// UnmarshalJSON provides a custom Unmarshal method for ImportMultiScriptPubKey
+// which accepts either a JSON string containing a hex-encoded script or a JSON
+// object containing an address.
+func (s *ImportMultiScriptPubKey) UnmarshalJSON(data []byte) error {
+	var script string
+	if err := json.Unmarshal(data, &script); err == nil {
+		*s = ImportMultiScriptPubKey{Script: script}
+		return nil
+	}
+
+	var addr importMultiAddress
+	if err := json.Unmarshal(data, &addr); err != nil {
+		return fmt.Errorf("scriptPubKey must be a script string or an "+
+			"object with an address (got %s)", data)
+	}
+	*s = ImportMultiScriptPubKey{Address: addr.Address}
+	return nil
+}
+
+// ImportMultiTimestamp is the creation time of the keys or scripts of an
+// importmulti request, which determines where a rescan starts.  It is
+// marshalled to and from JSON as a number of seconds since the Unix epoch, or
+// as the string "now" when Now is set so that no rescan is required.
+type ImportMultiTimestamp struct {
+	Time int64
+	Now  bool
+}
+
+// MarshalJSON provides a custom Marshal method for ImportMultiTimestamp.
+func (t ImportMultiTimestamp) MarshalJSON() ([]byte, error) {
+	if t.Now {
+		return json.Marshal("now")
+	}
+	return json.Marshal(t.Time)
+}
+
+// UnmarshalJSON provides a custom Unmarshal method for ImportMultiTimestamp
+// which accepts either a JSON number or the JSON string "now".
+func (t *ImportMultiTimestamp) UnmarshalJSON(data []byte) error {
+	var now string
+	if err := json.Unmarshal(data, &now); err == nil {
+		if now != "now" {
+			return fmt.Errorf("timestamp must be a number or \"now\" "+
+				"(got %q)", now)
+		}
+		*t = ImportMultiTimestamp{Now: true}
+		return nil
+	}
+
+	var unixTime int64
+	if err := json.Unmarshal(data, &unixTime); err != nil {
+		return fmt.Errorf("timestamp must be a number or \"now\" "+
+			"(got %s)", data)
+	}
+	*t = ImportMultiTimestamp{Time: unixTime}
+	return nil
+}
+
+// ImportMultiRequest describes a single script, address or set of keys to
+// import with the importmulti JSON-RPC command.  ScriptPubKey and Timestamp are
+// required.
+type ImportMultiRequest struct {
+	ScriptPubKey *ImportMultiScriptPubKey `json:"scriptPubKey"`
+	Timestamp    *ImportMultiTimestamp    `json:"timestamp"`
+	RedeemScript *string                  `json:"redeemscript,omitempty"`
+	PubKeys      []string                 `json:"pubkeys,omitempty"`
+	Keys         []string                 `json:"keys,omitempty"`
+	Internal     *bool                    `json:"internal,omitempty"`
+	WatchOnly    *bool                    `json:"watchonly,omitempty"`
+	Label        *string                  `json:"label,omitempty"`
+}
+
+// validate returns an error when the request, which is the passed entry of the
+// requests parameter, is missing a required field or has an invalid one.
+func (r *ImportMultiRequest) validate(i int) error {
+	field := func(name string) string {
+		return fmt.Sprintf("requests[%d].%s", i, name)
+	}
+
+	switch {
+	case r.ScriptPubKey == nil:
+		str := fmt.Sprintf("parameter '%s' is required",
+			field("scriptPubKey"))
+		return makeError(ErrInvalidParameter, str)
+	case r.ScriptPubKey.Address != "":
+		err := checkAddressParam(field("scriptPubKey"),
+			r.ScriptPubKey.Address)
+		if err != nil {
+			return err
+		}
+	default:
+		_, err := decodeHexParam(field("scriptPubKey"),
+			r.ScriptPubKey.Script)
+		if err != nil {
+			return err
+		}
+	}
+
+	if r.Timestamp == nil {
+		str := fmt.Sprintf("parameter '%s' is required",
+			field("timestamp"))
+		return makeError(ErrInvalidParameter, str)
+	}
+	if !r.Timestamp.Now && r.Timestamp.Time < 0 {
+		str := fmt.Sprintf("parameter '%s' must not be negative (got "+
+			"%d)", field("timestamp"), r.Timestamp.Time)
+		return makeError(ErrInvalidParameter, str)
+	}
+
+	if r.RedeemScript != nil {
+		_, err := decodeHexParam(field("redeemscript"), *r.RedeemScript)
+		if err != nil {
+			return err
+		}
+	}
+	for j, pubKey := range r.PubKeys {
+		name := fmt.Sprintf("%s[%d]", field("pubkeys"), j)
+		serializedKey, err := decodeHexParam(name, pubKey)
+		if err != nil {
+			return err
+		}
+		if len(serializedKey) != 33 && len(serializedKey) != 65 {
+			str := fmt.Sprintf("parameter '%s' must be a 33 or 65 "+
+				"byte public key (got %d bytes)", name,
+				len(serializedKey))
+			return makeError(ErrInvalidParameter, str)
+		}
+	}
+	for j, key := range r.Keys {
+		if _, err := btcutil.DecodeWIF(key); err != nil {
+			str := fmt.Sprintf("parameter '%s[%d]' must be a WIF "+
+				"encoded private key: %v", field("keys"), j, err)
+			return makeError(ErrInvalidParameter, str)
+		}
+	}
+
+	if r.Internal != nil && *r.Internal && r.Label != nil {
+		str := fmt.Sprintf("parameter '%s' must not be set for an "+
+			"internal request", field("label"))
+		return makeError(ErrInvalidParameter, str)
+	}
+	return nil
+}
+
+// ImportMultiOptions defines the optional settings of the importmulti JSON-RPC
+// command.
+type ImportMultiOptions struct {
+	Rescan *bool `json:"rescan,omitempty"`
+}
+
+// ImportMultiCmd defines the importmulti JSON-RPC command.  It imports several
+// scripts, addresses and keys at once, optionally rescanning the chain a single
+// time afterwards.
+type ImportMultiCmd struct {
+	Requests []ImportMultiRequest
+	Options  *ImportMultiOptions
+}
+
+// NewImportMultiCmd returns a new instance which can be used to issue an
+// importmulti JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewImportMultiCmd(requests []ImportMultiRequest, options *ImportMultiOptions) *ImportMultiCmd {
+	return &ImportMultiCmd{
+		Requests: requests,
+		Options:  options,
+	}
+}
+
+// validateParams ensures at least one request is provided and that each of them
+// is valid.
+func (c *ImportMultiCmd) validateParams() error {
+	if len(c.Requests) == 0 {
+		return makeError(ErrInvalidParameter, "parameter 'requests' "+
+			"must contain at least one request")
+	}
+	for i := range c.Requests {
+		if err := c.Requests[i].validate(i); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // ListAddressTransactionsCmd defines the listaddresstransactions JSON-RPC
 // command.
 type ListAddressTransactionsCmd struct {
@@ -89,6 +470,16 @@ func NewListAllTransactionsCmd(account *string) *ListAllTransactionsCmd {
 	}
 }
 
+// ListScriptsCmd defines the listscripts JSON-RPC command.  It returns the
+// redeem scripts that have been imported into the wallet.
+type ListScriptsCmd struct{}
+
+// NewListScriptsCmd returns a new instance which can be used to issue a
+// listscripts JSON-RPC command.
+func NewListScriptsCmd() *ListScriptsCmd {
+	return &ListScriptsCmd{}
+}
+
 // RecoverAddressesCmd defines the recoveraddresses JSON-RPC command.
 type RecoverAddressesCmd struct {
 	Account string
@@ -104,6 +495,98 @@ func NewRecoverAddressesCmd(account string, n int) *RecoverAddressesCmd {
 	}
 }
 
+// SetHDSeedCmd defines the sethdseed JSON-RPC command.  When no seed is given
+// the wallet generates a new one.  Its String method redacts the seed.
+type SetHDSeedCmd struct {
+	NewKeyPool *bool `jsonrpcdefault:"true"`
+	Seed       *string
+}
+
+// NewSetHDSeedCmd returns a new instance which can be used to issue a
+// sethdseed JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewSetHDSeedCmd(newKeyPool *bool, seed *string) *SetHDSeedCmd {
+	return &SetHDSeedCmd{
+		NewKeyPool: newKeyPool,
+		Seed:       seed,
+	}
+}
+
+// String returns a description of the command with the seed masked so the
+// command can be safely logged.
+func (c SetHDSeedCmd) String() string {
+	newKeyPool := "<default>"
+	if c.NewKeyPool != nil {
+		newKeyPool = fmt.Sprint(*c.NewKeyPool)
+	}
+	seed := "<none>"
+	if c.Seed != nil {
+		seed = "<redacted>"
+	}
+	return fmt.Sprintf("sethdseed(newkeypool=%s, seed=%s)", newKeyPool,
+		seed)
+}
+
+// validateParams ensures the seed, when given, is a WIF encoded private key.
+// The seed itself is never included in the error.
+func (c *SetHDSeedCmd) validateParams() error {
+	if c.Seed == nil {
+		return nil
+	}
+	if _, err := btcutil.DecodeWIF(*c.Seed); err != nil {
+		return makeError(ErrInvalidParameter, "parameter 'seed' must "+
+			"be a WIF encoded private key")
+	}
+	return nil
+}
+
+// SweepAccountCmd defines the sweepaccount JSON-RPC command.  It sends all of
+// the spendable outputs of the source account to the destination address.
+type SweepAccountCmd struct {
+	SourceAccount         string
+	DestinationAddress    string
+	RequiredConfirmations *int
+	FeeRate               *float64 `jsonrpcamount:"true"`
+}
+
+// NewSweepAccountCmd returns a new instance which can be used to issue a
+// sweepaccount JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will omit them from the request.
+func NewSweepAccountCmd(sourceAccount, destinationAddress string,
+	requiredConfirmations *int, feeRate *float64) *SweepAccountCmd {
+
+	return &SweepAccountCmd{
+		SourceAccount:         sourceAccount,
+		DestinationAddress:    destinationAddress,
+		RequiredConfirmations: requiredConfirmations,
+		FeeRate:               feeRate,
+	}
+}
+
+// validateParams ensures the destination address is valid, the number of
+// required confirmations is not negative and the fee rate is positive.
+func (c *SweepAccountCmd) validateParams() error {
+	err := checkAddressParam("destinationaddress", c.DestinationAddress)
+	if err != nil {
+		return err
+	}
+	if c.RequiredConfirmations != nil && *c.RequiredConfirmations < 0 {
+		str := fmt.Sprintf("parameter 'requiredconfirmations' must "+
+			"not be negative (got %d)", *c.RequiredConfirmations)
+		return makeError(ErrInvalidParameter, str)
+	}
+	if c.FeeRate != nil && !(*c.FeeRate > 0) {
+		str := fmt.Sprintf("parameter 'feerate' must be positive "+
+			"(got %v)", *c.FeeRate)
+		return makeError(ErrInvalidParameter, str)
+	}
+	return nil
+}
+
 // WalletIsLockedCmd defines the walletislocked JSON-RPC command.
 type WalletIsLockedCmd struct{}
 
@@ -118,11 +601,20 @@ func init() {
 	// websockets.
 	flags := UFWalletOnly | UFWebsocketOnly
 
+	MustRegisterCmd("abandontransaction", (*AbandonTransactionCmd)(nil), flags)
+	MustRegisterCmd("bumpfee", (*BumpFeeCmd)(nil), flags)
 	MustRegisterCmd("createencryptedwallet", (*CreateEncryptedWalletCmd)(nil), flags)
 	MustRegisterCmd("exportwatchingwallet", (*ExportWatchingWalletCmd)(nil), flags)
+	MustRegisterCmd("fundrawtransaction", (*FundRawTransactionCmd)(nil), flags)
+	MustRegisterCmd("getaccountinfo", (*GetAccountInfoCmd)(nil), flags)
+	MustRegisterCmd("gethdkeypoolinfo", (*GetHDKeypoolInfoCmd)(nil), flags)
 	MustRegisterCmd("getunconfirmedbalance", (*GetUnconfirmedBalanceCmd)(nil), flags)
+	MustRegisterCmd("importmulti", (*ImportMultiCmd)(nil), flags)
 	MustRegisterCmd("listaddresstransactions", (*ListAddressTransactionsCmd)(nil), flags)
 	MustRegisterCmd("listalltransactions", (*ListAllTransactionsCmd)(nil), flags)
+	MustRegisterCmd("listscripts", (*ListScriptsCmd)(nil), flags)
 	MustRegisterCmd("recoveraddresses", (*RecoverAddressesCmd)(nil), flags)
+	MustRegisterCmd("sethdseed", (*SetHDSeedCmd)(nil), flags)
+	MustRegisterCmd("sweepaccount", (*SweepAccountCmd)(nil), flags)
 	MustRegisterCmd("walletislocked", (*WalletIsLockedCmd)(nil), flags)
 }
@@ -9,6 +9,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/btcsuite/btcd/btcjson"
@@ -29,6 +30,73 @@ func TestWalletSvrWsCmds(t *testing.T) {
 		marshalled   string
 		unmarshalled interface{}
 	}{
+		{
+			name: "abandontransaction",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("abandontransaction", "0000000000000000000000000000000000000000000000000000000000000123")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewAbandonTransactionCmd("0000000000000000000000000000000000000000000000000000000000000123")
+			},
+			marshalled:   `{"jsonrpc":"1.0","method":"abandontransaction","params":["0000000000000000000000000000000000000000000000000000000000000123"],"id":1}`,
+			unmarshalled: &btcjson.AbandonTransactionCmd{TxID: "0000000000000000000000000000000000000000000000000000000000000123"},
+		},
+		{
+			name: "bumpfee",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("bumpfee", "0000000000000000000000000000000000000000000000000000000000000123")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewBumpFeeCmd("0000000000000000000000000000000000000000000000000000000000000123", nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"bumpfee","params":["0000000000000000000000000000000000000000000000000000000000000123"],"id":1}`,
+			unmarshalled: &btcjson.BumpFeeCmd{
+				TxID:    "0000000000000000000000000000000000000000000000000000000000000123",
+				Options: nil,
+			},
+		},
+		{
+			name: "bumpfee optional",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("bumpfee", "0000000000000000000000000000000000000000000000000000000000000123",
+					`{"confTarget":6,"replaceable":false}`)
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewBumpFeeCmd("0000000000000000000000000000000000000000000000000000000000000123",
+					&btcjson.BumpFeeOpts{
+						ConfTarget:  btcjson.Int(6),
+						Replaceable: btcjson.Bool(false),
+					})
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"bumpfee","params":["0000000000000000000000000000000000000000000000000000000000000123",{"confTarget":6,"replaceable":false}],"id":1}`,
+			unmarshalled: &btcjson.BumpFeeCmd{
+				TxID: "0000000000000000000000000000000000000000000000000000000000000123",
+				Options: &btcjson.BumpFeeOpts{
+					ConfTarget:  btcjson.Int(6),
+					Replaceable: btcjson.Bool(false),
+				},
+			},
+		},
+		{
+			name: "bumpfee total fee",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("bumpfee", "0000000000000000000000000000000000000000000000000000000000000123",
+					`{"totalFee":10000}`)
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewBumpFeeCmd("0000000000000000000000000000000000000000000000000000000000000123",
+					&btcjson.BumpFeeOpts{
+						TotalFee: btcjson.Int64(10000),
+					})
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"bumpfee","params":["0000000000000000000000000000000000000000000000000000000000000123",{"totalFee":10000}],"id":1}`,
+			unmarshalled: &btcjson.BumpFeeCmd{
+				TxID: "0000000000000000000000000000000000000000000000000000000000000123",
+				Options: &btcjson.BumpFeeOpts{
+					TotalFee: btcjson.Int64(10000),
+				},
+			},
+		},
 		{
 			name: "createencryptedwallet",
 			newCmd: func() (interface{}, error) {
@@ -83,6 +151,94 @@ func TestWalletSvrWsCmds(t *testing.T) {
 				Download: btcjson.Bool(true),
 			},
 		},
+		{
+			name: "fundrawtransaction",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("fundrawtransaction", "010000000111111111111111111111111111111111111111111111111111111111111111110000000000ffffffff01e803000000000000015100000000")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewFundRawTransactionCmd("010000000111111111111111111111111111111111111111111111111111111111111111110000000000ffffffff01e803000000000000015100000000", nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"fundrawtransaction","params":["010000000111111111111111111111111111111111111111111111111111111111111111110000000000ffffffff01e803000000000000015100000000"],"id":1}`,
+			unmarshalled: &btcjson.FundRawTransactionCmd{
+				HexTx:   "010000000111111111111111111111111111111111111111111111111111111111111111110000000000ffffffff01e803000000000000015100000000",
+				Options: nil,
+			},
+		},
+		{
+			name: "fundrawtransaction optional",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("fundrawtransaction", "010000000111111111111111111111111111111111111111111111111111111111111111110000000000ffffffff01e803000000000000015100000000",
+					`{"changeAddress":"1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2","feeRate":0.0002,"includeWatching":true}`)
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewFundRawTransactionCmd("010000000111111111111111111111111111111111111111111111111111111111111111110000000000ffffffff01e803000000000000015100000000",
+					&btcjson.FundRawTransactionOpts{
+						ChangeAddress:   btcjson.String("1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"),
+						FeeRate:         btcjson.Float64(0.0002),
+						IncludeWatching: btcjson.Bool(true),
+					})
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"fundrawtransaction","params":["010000000111111111111111111111111111111111111111111111111111111111111111110000000000ffffffff01e803000000000000015100000000",{"changeAddress":"1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2","feeRate":0.0002,"includeWatching":true}],"id":1}`,
+			unmarshalled: &btcjson.FundRawTransactionCmd{
+				HexTx: "010000000111111111111111111111111111111111111111111111111111111111111111110000000000ffffffff01e803000000000000015100000000",
+				Options: &btcjson.FundRawTransactionOpts{
+					ChangeAddress:   btcjson.String("1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"),
+					FeeRate:         btcjson.Float64(0.0002),
+					IncludeWatching: btcjson.Bool(true),
+				},
+			},
+		},
+		{
+			name: "fundrawtransaction no inputs",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("fundrawtransaction", "010000000001e803000000000000015100000000")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewFundRawTransactionCmd("010000000001e803000000000000015100000000", nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"fundrawtransaction","params":["010000000001e803000000000000015100000000"],"id":1}`,
+			unmarshalled: &btcjson.FundRawTransactionCmd{
+				HexTx: "010000000001e803000000000000015100000000",
+			},
+		},
+		{
+			name: "getaccountinfo",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("getaccountinfo", "acct")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewGetAccountInfoCmd("acct")
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getaccountinfo","params":["acct"],"id":1}`,
+			unmarshalled: &btcjson.GetAccountInfoCmd{
+				Account: "acct",
+			},
+		},
+		{
+			name: "getaccountinfo default account",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("getaccountinfo", "")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewGetAccountInfoCmd("")
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getaccountinfo","params":[""],"id":1}`,
+			unmarshalled: &btcjson.GetAccountInfoCmd{
+				Account: "",
+			},
+		},
+		{
+			name: "gethdkeypoolinfo",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("gethdkeypoolinfo")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewGetHDKeypoolInfoCmd()
+			},
+			marshalled:   `{"jsonrpc":"1.0","method":"gethdkeypoolinfo","params":[],"id":1}`,
+			unmarshalled: &btcjson.GetHDKeypoolInfoCmd{},
+		},
 		{
 			name: "getunconfirmedbalance",
 			newCmd: func() (interface{}, error) {
@@ -109,6 +265,82 @@ func TestWalletSvrWsCmds(t *testing.T) {
 				Account: btcjson.String("acct"),
 			},
 		},
+		{
+			name: "importmulti",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("importmulti",
+					`[{"scriptPubKey":"76a914","timestamp":1500000000}]`)
+			},
+			staticCmd: func() interface{} {
+				requests := []btcjson.ImportMultiRequest{{
+					ScriptPubKey: &btcjson.ImportMultiScriptPubKey{
+						Script: "76a914",
+					},
+					Timestamp: &btcjson.ImportMultiTimestamp{
+						Time: 1500000000,
+					},
+				}}
+				return btcjson.NewImportMultiCmd(requests, nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"importmulti","params":[[{"scriptPubKey":"76a914","timestamp":1500000000}]],"id":1}`,
+			unmarshalled: &btcjson.ImportMultiCmd{
+				Requests: []btcjson.ImportMultiRequest{{
+					ScriptPubKey: &btcjson.ImportMultiScriptPubKey{
+						Script: "76a914",
+					},
+					Timestamp: &btcjson.ImportMultiTimestamp{
+						Time: 1500000000,
+					},
+				}},
+				Options: nil,
+			},
+		},
+		{
+			name: "importmulti optional",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("importmulti",
+					`[{"scriptPubKey":{"address":"1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"},"timestamp":"now","redeemscript":"51","pubkeys":["0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798"],"keys":["5HueCGU8rMjxEXxiPuD5BDku4MkFqeZyd4dZ1jvhTVqvbTLvyTJ"],"watchonly":false,"label":"imported"}]`,
+					`{"rescan":false}`)
+			},
+			staticCmd: func() interface{} {
+				requests := []btcjson.ImportMultiRequest{{
+					ScriptPubKey: &btcjson.ImportMultiScriptPubKey{
+						Address: "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2",
+					},
+					Timestamp: &btcjson.ImportMultiTimestamp{
+						Now: true,
+					},
+					RedeemScript: btcjson.String("51"),
+					PubKeys:      []string{"0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798"},
+					Keys:         []string{"5HueCGU8rMjxEXxiPuD5BDku4MkFqeZyd4dZ1jvhTVqvbTLvyTJ"},
+					WatchOnly:    btcjson.Bool(false),
+					Label:        btcjson.String("imported"),
+				}}
+				options := &btcjson.ImportMultiOptions{
+					Rescan: btcjson.Bool(false),
+				}
+				return btcjson.NewImportMultiCmd(requests, options)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"importmulti","params":[[{"scriptPubKey":{"address":"1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"},"timestamp":"now","redeemscript":"51","pubkeys":["0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798"],"keys":["5HueCGU8rMjxEXxiPuD5BDku4MkFqeZyd4dZ1jvhTVqvbTLvyTJ"],"watchonly":false,"label":"imported"}],{"rescan":false}],"id":1}`,
+			unmarshalled: &btcjson.ImportMultiCmd{
+				Requests: []btcjson.ImportMultiRequest{{
+					ScriptPubKey: &btcjson.ImportMultiScriptPubKey{
+						Address: "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2",
+					},
+					Timestamp: &btcjson.ImportMultiTimestamp{
+						Now: true,
+					},
+					RedeemScript: btcjson.String("51"),
+					PubKeys:      []string{"0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798"},
+					Keys:         []string{"5HueCGU8rMjxEXxiPuD5BDku4MkFqeZyd4dZ1jvhTVqvbTLvyTJ"},
+					WatchOnly:    btcjson.Bool(false),
+					Label:        btcjson.String("imported"),
+				}},
+				Options: &btcjson.ImportMultiOptions{
+					Rescan: btcjson.Bool(false),
+				},
+			},
+		},
 		{
 			name: "listaddresstransactions",
 			newCmd: func() (interface{}, error) {
@@ -164,6 +396,17 @@ func TestWalletSvrWsCmds(t *testing.T) {
 				Account: btcjson.String("acct"),
 			},
 		},
+		{
+			name: "listscripts",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("listscripts")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewListScriptsCmd()
+			},
+			marshalled:   `{"jsonrpc":"1.0","method":"listscripts","params":[],"id":1}`,
+			unmarshalled: &btcjson.ListScriptsCmd{},
+		},
 		{
 			name: "recoveraddresses",
 			newCmd: func() (interface{}, error) {
@@ -178,6 +421,79 @@ func TestWalletSvrWsCmds(t *testing.T) {
 				N:       10,
 			},
 		},
+		{
+			name: "sethdseed",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("sethdseed")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewSetHDSeedCmd(nil, nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"sethdseed","params":[],"id":1}`,
+			unmarshalled: &btcjson.SetHDSeedCmd{
+				NewKeyPool: btcjson.Bool(true),
+			},
+		},
+		{
+			name: "sethdseed optional1",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("sethdseed", false)
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewSetHDSeedCmd(btcjson.Bool(false), nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"sethdseed","params":[false],"id":1}`,
+			unmarshalled: &btcjson.SetHDSeedCmd{
+				NewKeyPool: btcjson.Bool(false),
+			},
+		},
+		{
+			name: "sethdseed optional2",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("sethdseed", true, "5HueCGU8rMjxEXxiPuD5BDku4MkFqeZyd4dZ1jvhTVqvbTLvyTJ")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewSetHDSeedCmd(btcjson.Bool(true), btcjson.String("5HueCGU8rMjxEXxiPuD5BDku4MkFqeZyd4dZ1jvhTVqvbTLvyTJ"))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"sethdseed","params":[true,"5HueCGU8rMjxEXxiPuD5BDku4MkFqeZyd4dZ1jvhTVqvbTLvyTJ"],"id":1}`,
+			unmarshalled: &btcjson.SetHDSeedCmd{
+				NewKeyPool: btcjson.Bool(true),
+				Seed:       btcjson.String("5HueCGU8rMjxEXxiPuD5BDku4MkFqeZyd4dZ1jvhTVqvbTLvyTJ"),
+			},
+		},
+		{
+			name: "sweepaccount",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("sweepaccount", "acct", "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewSweepAccountCmd("acct",
+					"1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2", nil, nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"sweepaccount","params":["acct","1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"],"id":1}`,
+			unmarshalled: &btcjson.SweepAccountCmd{
+				SourceAccount:      "acct",
+				DestinationAddress: "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2",
+			},
+		},
+		{
+			name: "sweepaccount optional",
+			newCmd: func() (interface{}, error) {
+				return btcjson.NewCmd("sweepaccount", "acct", "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2", 6, 0.0001)
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewSweepAccountCmd("acct",
+					"1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2",
+					btcjson.Int(6), btcjson.Float64(0.0001))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"sweepaccount","params":["acct","1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2",6,0.0001],"id":1}`,
+			unmarshalled: &btcjson.SweepAccountCmd{
+				SourceAccount:         "acct",
+				DestinationAddress:    "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2",
+				RequiredConfirmations: btcjson.Int(6),
+				FeeRate:               btcjson.Float64(0.0001),
+			},
+		},
 		{
 			name: "walletislocked",
 			newCmd: func() (interface{}, error) {
@@ -209,6 +525,13 @@ func TestWalletSvrWsCmds(t *testing.T) {
 			continue
 		}
 
+		// Ensure the params reported for the command match the
+		// marshalled params.
+		if err := checkCmdParams(test.staticCmd(), marshalled); err != nil {
+			t.Errorf("Test #%d (%s) %v", i, test.name, err)
+			continue
+		}
+
 		// Ensure the command is created without error via the generic
 		// new command creation function.
 		cmd, err := test.newCmd()
@@ -257,3 +580,303 @@ func TestWalletSvrWsCmds(t *testing.T) {
 		}
 	}
 }
+
+// TestSetHDSeedCmdString ensures the string representation of the sethdseed
+// command never includes the seed.
+func TestSetHDSeedCmdString(t *testing.T) {
+	t.Parallel()
+
+	const seed = "5HueCGU8rMjxEXxiPuD5BDku4MkFqeZyd4dZ1jvhTVqvbTLvyTJ"
+	cmd := btcjson.NewSetHDSeedCmd(btcjson.Bool(false), btcjson.String(seed))
+	for _, str := range []string{cmd.String(), fmt.Sprintf("%v", cmd)} {
+		if strings.Contains(str, seed) {
+			t.Fatalf("string representation %q leaks the seed", str)
+		}
+		if !strings.Contains(str, "newkeypool=false") {
+			t.Fatalf("string representation %q is missing the "+
+				"newkeypool flag", str)
+		}
+	}
+}
+
+// TestWalletSvrWsCmdParamErrors ensures UnmarshalCmd rejects wallet server
+// websocket commands whose parameters do not satisfy the requirements of the
+// command.
+func TestWalletSvrWsCmdParamErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []unmarshalCmdErrorTest{
+		{
+			name: "invalid destination for sweepaccount",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "sweepaccount",
+				Params:  []json.RawMessage{[]byte(`"acct"`), []byte(`"1Address"`)},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name: "negative confirmations for sweepaccount",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "sweepaccount",
+				Params:  []json.RawMessage{[]byte(`"acct"`), []byte(`"1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"`), []byte(`-1`)},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name: "zero fee rate for sweepaccount",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "sweepaccount",
+				Params:  []json.RawMessage{[]byte(`"acct"`), []byte(`"1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"`), []byte(`1`), []byte(`0`)},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name: "invalid transaction for fundrawtransaction",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "fundrawtransaction",
+				Params:  []json.RawMessage{[]byte(`"0100"`)},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name: "invalid option type for fundrawtransaction",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "fundrawtransaction",
+				Params:  []json.RawMessage{[]byte(`"010000000111111111111111111111111111111111111111111111111111111111111111110000000000ffffffff01e803000000000000015100000000"`), []byte(`{"includeWatching":"yes"}`)},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidType},
+		},
+		{
+			name: "invalid change address for fundrawtransaction",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "fundrawtransaction",
+				Params:  []json.RawMessage{[]byte(`"010000000111111111111111111111111111111111111111111111111111111111111111110000000000ffffffff01e803000000000000015100000000"`), []byte(`{"changeAddress":"1Address"}`)},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name: "invalid fee rate for fundrawtransaction",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "fundrawtransaction",
+				Params:  []json.RawMessage{[]byte(`"010000000111111111111111111111111111111111111111111111111111111111111111110000000000ffffffff01e803000000000000015100000000"`), []byte(`{"feeRate":-1}`)},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name: "malformed txid for abandontransaction",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "abandontransaction",
+				Params:  []json.RawMessage{[]byte(`"xyz"`)},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name: "malformed txid for bumpfee",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "bumpfee",
+				Params:  []json.RawMessage{[]byte(`"xyz"`)},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name: "invalid option type for bumpfee",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "bumpfee",
+				Params:  []json.RawMessage{[]byte(`"123"`), []byte(`{"confTarget":"6"}`)},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidType},
+		},
+		{
+			name: "zero confirmation target for bumpfee",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "bumpfee",
+				Params:  []json.RawMessage{[]byte(`"123"`), []byte(`{"confTarget":0}`)},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name: "negative total fee for bumpfee",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "bumpfee",
+				Params:  []json.RawMessage{[]byte(`"123"`), []byte(`{"totalFee":-1}`)},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name: "confirmation target and total fee for bumpfee",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "bumpfee",
+				Params:  []json.RawMessage{[]byte(`"123"`), []byte(`{"confTarget":6,"totalFee":10000}`)},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name: "empty requests for importmulti",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "importmulti",
+				Params:  []json.RawMessage{[]byte(`[]`)},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name: "missing scriptPubKey for importmulti",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "importmulti",
+				Params:  []json.RawMessage{[]byte(`[{"timestamp":0}]`)},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name: "missing timestamp for importmulti",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "importmulti",
+				Params:  []json.RawMessage{[]byte(`[{"scriptPubKey":"51"},{"scriptPubKey":"51"}]`)},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name: "non-hex scriptPubKey for importmulti",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "importmulti",
+				Params:  []json.RawMessage{[]byte(`[{"scriptPubKey":"xyz","timestamp":0}]`)},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name: "invalid address for importmulti",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "importmulti",
+				Params:  []json.RawMessage{[]byte(`[{"scriptPubKey":{"address":"1Address"},"timestamp":0}]`)},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name: "negative timestamp for importmulti",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "importmulti",
+				Params:  []json.RawMessage{[]byte(`[{"scriptPubKey":"51","timestamp":-1}]`)},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name: "short pubkey for importmulti",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "importmulti",
+				Params:  []json.RawMessage{[]byte(`[{"scriptPubKey":"51","timestamp":0,"pubkeys":["0279"]}]`)},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name: "invalid private key for importmulti",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "importmulti",
+				Params:  []json.RawMessage{[]byte(`[{"scriptPubKey":"51","timestamp":0,"keys":["xyz"]}]`)},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name: "label for internal importmulti request",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "importmulti",
+				Params:  []json.RawMessage{[]byte(`[{"scriptPubKey":"51","timestamp":0,"internal":true,"label":"x"}]`)},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name: "invalid timestamp string for importmulti",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "importmulti",
+				Params:  []json.RawMessage{[]byte(`[{"scriptPubKey":"51","timestamp":"later"}]`)},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidType},
+		},
+		{
+			name: "invalid scriptPubKey type for importmulti",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "importmulti",
+				Params:  []json.RawMessage{[]byte(`[{"scriptPubKey":1,"timestamp":0}]`)},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidType},
+		},
+		{
+			name: "all accounts for getaccountinfo",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "getaccountinfo",
+				Params:  []json.RawMessage{[]byte(`" * "`)},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name: "missing account for getaccountinfo",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "getaccountinfo",
+				Params:  []json.RawMessage{},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrTooFewParams},
+		},
+		{
+			name: "sethdseed invalid seed",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "sethdseed",
+				Params: []json.RawMessage{[]byte(`true`),
+					[]byte(`"5HueCGU8rMjxEXxiPuD5BDku4MkFqeZyd4dZ1jvhTVqvbTLvyTj"`)},
+				ID: nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+	}
+	testUnmarshalCmdErrors(t, tests)
+}
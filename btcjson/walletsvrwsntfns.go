@@ -7,6 +7,8 @@
 
 package btcjson
 
+import "fmt"
+
 const (
 	// AccountBalanceNtfnMethod is the method used for account balance
 	// notifications.
@@ -20,6 +22,15 @@ const (
 	// of a wallet has changed.
 	WalletLockStateNtfnMethod = "walletlockstate"
 
+	// WalletPassphraseChangedNtfnMethod is the method used to notify that
+	// the passphrase of a wallet has changed along with the number of
+	// seconds remaining before the wallet is locked again.
+	WalletPassphraseChangedNtfnMethod = "walletpassphrasechanged"
+
+	// ImportRescanProgressNtfnMethod is the method used to notify the
+	// progress of the rescan started by importing an address.
+	ImportRescanProgressNtfnMethod = "importrescanprogress"
+
 	// NewTxNtfnMethod is the method used to notify that a wallet server has
 	// added a new transaction to the transaction store.
 	NewTxNtfnMethod = "newtx"
@@ -68,6 +79,54 @@ func NewWalletLockStateNtfn(locked bool) *WalletLockStateNtfn {
 	}
 }
 
+// WalletPassphraseChangedNtfn defines the walletpassphrasechanged JSON-RPC
+// notification.
+type WalletPassphraseChangedNtfn struct {
+	Account       string
+	UnlockSeconds int64
+}
+
+// NewWalletPassphraseChangedNtfn returns a new instance which can be used to
+// issue a walletpassphrasechanged JSON-RPC notification.
+func NewWalletPassphraseChangedNtfn(account string, unlockSeconds int64) *WalletPassphraseChangedNtfn {
+	return &WalletPassphraseChangedNtfn{
+		Account:       account,
+		UnlockSeconds: unlockSeconds,
+	}
+}
+
+// ImportRescanProgressNtfn defines the importrescanprogress JSON-RPC
+// notification.
+type ImportRescanProgressNtfn struct {
+	Address string
+	Height  int32
+	Percent float64
+}
+
+// NewImportRescanProgressNtfn returns a new instance which can be used to issue
+// an importrescanprogress JSON-RPC notification.
+func NewImportRescanProgressNtfn(address string, height int32, percent float64) *ImportRescanProgressNtfn {
+	return &ImportRescanProgressNtfn{
+		Address: address,
+		Height:  height,
+		Percent: percent,
+	}
+}
+
+// validateParams ensures the address is valid and the percentage complete is
+// within [0, 100].
+func (n *ImportRescanProgressNtfn) validateParams() error {
+	if err := checkAddressParam("address", n.Address); err != nil {
+		return err
+	}
+	if n.Percent < 0 || n.Percent > 100 {
+		str := fmt.Sprintf("parameter 'percent' must be between 0 "+
+			"and 100 (got %v)", n.Percent)
+		return makeError(ErrInvalidParameter, str)
+	}
+	return nil
+}
+
 // NewTxNtfn defines the newtx JSON-RPC notification.
 type NewTxNtfn struct {
 	Account string
@@ -91,5 +150,7 @@ func init() {
 	MustRegisterCmd(AccountBalanceNtfnMethod, (*AccountBalanceNtfn)(nil), flags)
 	MustRegisterCmd(BtcdConnectedNtfnMethod, (*BtcdConnectedNtfn)(nil), flags)
 	MustRegisterCmd(WalletLockStateNtfnMethod, (*WalletLockStateNtfn)(nil), flags)
+	MustRegisterCmd(WalletPassphraseChangedNtfnMethod, (*WalletPassphraseChangedNtfn)(nil), flags)
+	MustRegisterCmd(ImportRescanProgressNtfnMethod, (*ImportRescanProgressNtfn)(nil), flags)
 	MustRegisterCmd(NewTxNtfnMethod, (*NewTxNtfn)(nil), flags)
 }
@@ -69,6 +69,35 @@ func TestWalletSvrWsNtfns(t *testing.T) {
 				Locked: true,
 			},
 		},
+		{
+			name: "walletpassphrasechanged",
+			newNtfn: func() (interface{}, error) {
+				return btcjson.NewCmd("walletpassphrasechanged", "acct", 60)
+			},
+			staticNtfn: func() interface{} {
+				return btcjson.NewWalletPassphraseChangedNtfn("acct", 60)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"walletpassphrasechanged","params":["acct",60],"id":null}`,
+			unmarshalled: &btcjson.WalletPassphraseChangedNtfn{
+				Account:       "acct",
+				UnlockSeconds: 60,
+			},
+		},
+		{
+			name: "importrescanprogress",
+			newNtfn: func() (interface{}, error) {
+				return btcjson.NewCmd("importrescanprogress", "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2", 100000, 42.5)
+			},
+			staticNtfn: func() interface{} {
+				return btcjson.NewImportRescanProgressNtfn("1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2", 100000, 42.5)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"importrescanprogress","params":["1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2",100000,42.5],"id":null}`,
+			unmarshalled: &btcjson.ImportRescanProgressNtfn{
+				Address: "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2",
+				Height:  100000,
+				Percent: 42.5,
+			},
+		},
 		{
 			name: "newtx",
 			newNtfn: func() (interface{}, error) {
@@ -136,6 +165,13 @@ func TestWalletSvrWsNtfns(t *testing.T) {
 			continue
 		}
 
+		// Ensure the params reported for the notification match the
+		// marshalled params.
+		if err := checkCmdParams(test.staticNtfn(), marshalled); err != nil {
+			t.Errorf("Test #%d (%s) %v", i, test.name, err)
+			continue
+		}
+
 		// Ensure the notification is created without error via the
 		// generic new notification creation function.
 		cmd, err := test.newNtfn()
@@ -185,3 +221,58 @@ func TestWalletSvrWsNtfns(t *testing.T) {
 		}
 	}
 }
+
+// TestWalletSvrWsNtfnParamErrors ensures UnmarshalCmd rejects wallet server
+// websocket notifications whose parameters do not satisfy the requirements of
+// the command.
+func TestWalletSvrWsNtfnParamErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []unmarshalCmdErrorTest{
+		{
+			name: "missing unlock seconds for walletpassphrasechanged",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "walletpassphrasechanged",
+				Params:  []json.RawMessage{[]byte(`"acct"`)},
+				ID:      nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrTooFewParams},
+		},
+		{
+			name: "non-integer unlock seconds for walletpassphrasechanged",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "walletpassphrasechanged",
+				Params: []json.RawMessage{[]byte(`"acct"`),
+					[]byte(`"60"`)},
+				ID: nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidType},
+		},
+		{
+			name: "invalid address for importrescanprogress",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "importrescanprogress",
+				Params: []json.RawMessage{[]byte(`"1Address"`),
+					[]byte(`100000`), []byte(`42.5`)},
+				ID: nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+		{
+			name: "out of range percent for importrescanprogress",
+			request: btcjson.Request{
+				Jsonrpc: "1.0",
+				Method:  "importrescanprogress",
+				Params: []json.RawMessage{
+					[]byte(`"1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"`),
+					[]byte(`100000`), []byte(`100.5`)},
+				ID: nil,
+			},
+			err: btcjson.Error{ErrorCode: btcjson.ErrInvalidParameter},
+		},
+	}
+	testUnmarshalCmdErrors(t, tests)
+}
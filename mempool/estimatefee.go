@@ -54,6 +54,10 @@ var (
 	// EstimateFeeDatabaseKey is the key that we use to
 	// store the fee estimator in the database.
 	EstimateFeeDatabaseKey = []byte("estimatefee")
+
+	// ErrNotEnoughBlocks is returned by EstimateFee when fewer than the
+	// minimum number of blocks have been observed to produce an estimate.
+	ErrNotEnoughBlocks = errors.New("not enough blocks have been observed")
 )
 
 // SatoshiPerByte is number with units of satoshis per byte.
@@ -553,7 +557,7 @@ func (ef *FeeEstimator) EstimateFee(numBlocks uint32) (BtcPerKilobyte, error) {
 	// If the number of registered blocks is below the minimum, return
 	// an error.
 	if ef.numBlocksRegistered < ef.minRegisteredBlocks {
-		return -1, errors.New("not enough blocks have been observed")
+		return -1, ErrNotEnoughBlocks
 	}
 
 	if numBlocks == 0 {
@@ -256,6 +256,29 @@ func TestEstimateFee(t *testing.T) {
 	}
 }
 
+// TestEstimateFeeNotEnoughBlocks ensures the FeeEstimator reports
+// ErrNotEnoughBlocks until the minimum number of blocks have been registered.
+func TestEstimateFeeNotEnoughBlocks(t *testing.T) {
+	ef := newTestFeeEstimator(5, 3, 1)
+	ef.minRegisteredBlocks = 1
+	eft := estimateFeeTester{ef: ef, t: t}
+
+	estimated, err := ef.EstimateFee(1)
+	if err != ErrNotEnoughBlocks {
+		t.Fatalf("EstimateFee: expected error %v, got %v",
+			ErrNotEnoughBlocks, err)
+	}
+	if estimated != -1 {
+		t.Fatalf("EstimateFee: expected -1, got %f", estimated)
+	}
+
+	// Once a block has been registered an estimate should be provided.
+	eft.newBlock([]*wire.MsgTx{})
+	if _, err := ef.EstimateFee(1); err != nil {
+		t.Fatalf("EstimateFee: unexpected error: %v", err)
+	}
+}
+
 func (eft *estimateFeeTester) estimates() [estimateFeeDepth]BtcPerKilobyte {
 
 	// Generate estimates
@@ -1183,39 +1183,162 @@ func (mp *TxPool) RawMempoolVerbose() map[string]*btcjson.GetRawMempoolVerboseRe
 	bestHeight := mp.cfg.BestHeight()
 
 	for _, desc := range mp.pool {
-		// Calculate the current priority based on the inputs to
-		// the transaction.  Use zero if one or more of the
-		// input transactions can't be found for some reason.
-		tx := desc.Tx
-		var currentPriority float64
-		utxos, err := mp.fetchInputUtxos(tx)
-		if err == nil {
-			currentPriority = mining.CalcPriority(tx.MsgTx(), utxos,
-				bestHeight+1)
+		result[desc.Tx.Hash().String()] = mp.rawMempoolVerboseEntry(desc,
+			bestHeight)
+	}
+
+	return result
+}
+
+// RawMempoolEntryVerbose returns the verbose details of the transaction with
+// the passed hash, in the same form as the entries of RawMempoolVerbose.  An
+// error is returned if the transaction is not in the main pool.
+//
+// This function is safe for concurrent access.
+func (mp *TxPool) RawMempoolEntryVerbose(txHash *chainhash.Hash) (*btcjson.GetRawMempoolVerboseResult, error) {
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
+	desc, exists := mp.pool[*txHash]
+	if !exists {
+		return nil, fmt.Errorf("transaction is not in the pool")
+	}
+
+	return mp.rawMempoolVerboseEntry(desc, mp.cfg.BestHeight()), nil
+}
+
+// TxPackageStats describes a transaction in the pool together with either all
+// of its in-pool ancestors or all of its in-pool descendants.
+type TxPackageStats struct {
+	// Count is the number of transactions, including the transaction
+	// itself.
+	Count int64
+
+	// Size is the total serialized size of the transactions in bytes.
+	Size int64
+
+	// Fees is the total fees paid by the transactions in satoshi.
+	Fees int64
+}
+
+// PackageStats returns the statistics of the transaction with the passed hash
+// together with its in-pool ancestors and together with its in-pool
+// descendants.  An error is returned if the transaction is not in the main
+// pool.
+//
+// This function is safe for concurrent access.
+func (mp *TxPool) PackageStats(txHash *chainhash.Hash) (TxPackageStats, TxPackageStats, error) {
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
+	desc, exists := mp.pool[*txHash]
+	if !exists {
+		return TxPackageStats{}, TxPackageStats{},
+			fmt.Errorf("transaction is not in the pool")
+	}
+
+	ancestors := mp.packageStats(desc, mp.parentDescs)
+	descendants := mp.packageStats(desc, mp.childDescs)
+	return ancestors, descendants, nil
+}
+
+// packageStats returns the statistics of the passed transaction descriptor and
+// every descriptor reachable from it through the passed function, which
+// returns either the direct parents or the direct children of a descriptor.
+//
+// This function MUST be called with the mempool lock held (for reads).
+func (mp *TxPool) packageStats(desc *TxDesc, related func(*TxDesc) []*TxDesc) TxPackageStats {
+	var stats TxPackageStats
+	seen := map[chainhash.Hash]struct{}{*desc.Tx.Hash(): {}}
+	queue := []*TxDesc{desc}
+	for len(queue) > 0 {
+		desc := queue[0]
+		queue = queue[1:]
+
+		stats.Count++
+		stats.Size += int64(desc.Tx.MsgTx().SerializeSize())
+		stats.Fees += desc.Fee
+
+		for _, relative := range related(desc) {
+			hash := *relative.Tx.Hash()
+			if _, ok := seen[hash]; ok {
+				continue
+			}
+			seen[hash] = struct{}{}
+			queue = append(queue, relative)
 		}
+	}
+	return stats
+}
 
-		mpd := &btcjson.GetRawMempoolVerboseResult{
-			Size:             int32(tx.MsgTx().SerializeSize()),
-			Vsize:            int32(GetTxVirtualSize(tx)),
-			Fee:              btcutil.Amount(desc.Fee).ToBTC(),
-			Time:             desc.Added.Unix(),
-			Height:           int64(desc.Height),
-			StartingPriority: desc.StartingPriority,
-			CurrentPriority:  currentPriority,
-			Depends:          make([]string, 0),
+// parentDescs returns the descriptors of the transactions in the main pool
+// which the passed transaction spends outputs of.
+//
+// This function MUST be called with the mempool lock held (for reads).
+func (mp *TxPool) parentDescs(desc *TxDesc) []*TxDesc {
+	var parents []*TxDesc
+	for _, txIn := range desc.Tx.MsgTx().TxIn {
+		if parent, ok := mp.pool[txIn.PreviousOutPoint.Hash]; ok {
+			parents = append(parents, parent)
 		}
-		for _, txIn := range tx.MsgTx().TxIn {
-			hash := &txIn.PreviousOutPoint.Hash
-			if mp.haveTransaction(hash) {
-				mpd.Depends = append(mpd.Depends,
-					hash.String())
-			}
+	}
+	return parents
+}
+
+// childDescs returns the descriptors of the transactions in the main pool
+// which spend outputs of the passed transaction.
+//
+// This function MUST be called with the mempool lock held (for reads).
+func (mp *TxPool) childDescs(desc *TxDesc) []*TxDesc {
+	var children []*TxDesc
+	prevOut := wire.OutPoint{Hash: *desc.Tx.Hash()}
+	for i := range desc.Tx.MsgTx().TxOut {
+		prevOut.Index = uint32(i)
+		spender, ok := mp.outpoints[prevOut]
+		if !ok {
+			continue
+		}
+		if child, ok := mp.pool[*spender.Hash()]; ok {
+			children = append(children, child)
 		}
+	}
+	return children
+}
+
+// rawMempoolVerboseEntry returns the verbose details of the passed transaction
+// descriptor.
+//
+// This function MUST be called with the mempool lock held (for reads).
+func (mp *TxPool) rawMempoolVerboseEntry(desc *TxDesc, bestHeight int32) *btcjson.GetRawMempoolVerboseResult {
+	// Calculate the current priority based on the inputs to the
+	// transaction.  Use zero if one or more of the input transactions
+	// can't be found for some reason.
+	tx := desc.Tx
+	var currentPriority float64
+	utxos, err := mp.fetchInputUtxos(tx)
+	if err == nil {
+		currentPriority = mining.CalcPriority(tx.MsgTx(), utxos,
+			bestHeight+1)
+	}
 
-		result[tx.Hash().String()] = mpd
+	mpd := &btcjson.GetRawMempoolVerboseResult{
+		Size:             int32(tx.MsgTx().SerializeSize()),
+		Vsize:            int32(GetTxVirtualSize(tx)),
+		Fee:              btcutil.Amount(desc.Fee).ToBTC(),
+		Time:             desc.Added.Unix(),
+		Height:           int64(desc.Height),
+		StartingPriority: desc.StartingPriority,
+		CurrentPriority:  currentPriority,
+		Depends:          make([]string, 0),
+	}
+	for _, txIn := range tx.MsgTx().TxIn {
+		hash := &txIn.PreviousOutPoint.Hash
+		if mp.haveTransaction(hash) {
+			mpd.Depends = append(mpd.Depends, hash.String())
+		}
 	}
 
-	return result
+	return mpd
 }
 
 // LastUpdated returns the last time a transaction was added to or removed from
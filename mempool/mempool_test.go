@@ -866,3 +866,145 @@ func TestCheckSpend(t *testing.T) {
 		t.Fatalf("Unexpeced spend found in pool: %v", spend)
 	}
 }
+
+// TestRawMempoolEntryVerbose ensures the verbose details of a single pool
+// transaction match its entry in RawMempoolVerbose and that transactions which
+// are not in the main pool are rejected.
+func TestRawMempoolEntryVerbose(t *testing.T) {
+	t.Parallel()
+
+	harness, outputs, err := newPoolHarness(&chaincfg.MainNetParams)
+	if err != nil {
+		t.Fatalf("unable to create test pool: %v", err)
+	}
+
+	chainedTxns, err := harness.CreateTxChain(outputs[0], 2)
+	if err != nil {
+		t.Fatalf("unable to create transaction chain: %v", err)
+	}
+
+	// Only accept the first transaction so the second one is not in the
+	// pool.
+	_, err = harness.txPool.ProcessTransaction(chainedTxns[0], false,
+		false, 0)
+	if err != nil {
+		t.Fatalf("ProcessTransaction: failed to accept tx: %v", err)
+	}
+
+	entry, err := harness.txPool.RawMempoolEntryVerbose(chainedTxns[0].Hash())
+	if err != nil {
+		t.Fatalf("RawMempoolEntryVerbose: unexpected error: %v", err)
+	}
+	want := harness.txPool.RawMempoolVerbose()[chainedTxns[0].Hash().String()]
+	if !reflect.DeepEqual(entry, want) {
+		t.Fatalf("RawMempoolEntryVerbose: got %+v, want %+v", entry,
+			want)
+	}
+
+	_, err = harness.txPool.RawMempoolEntryVerbose(chainedTxns[1].Hash())
+	if err == nil {
+		t.Fatalf("RawMempoolEntryVerbose: unexpected success for " +
+			"transaction not in the pool")
+	}
+}
+
+// TestPackageStats ensures the ancestor and descendant statistics of pool
+// transactions cover every in-pool ancestor and descendant exactly once, even
+// when they are reachable through more than one path.
+func TestPackageStats(t *testing.T) {
+	t.Parallel()
+
+	harness, outputs, err := newPoolHarness(&chaincfg.MainNetParams)
+	if err != nil {
+		t.Fatalf("unable to create test pool: %v", err)
+	}
+
+	// Create a diamond where a parent with two outputs is spent by two
+	// children which are both spent by a single grandchild.
+	parent, err := harness.CreateSignedTx(outputs[0:1], 2)
+	if err != nil {
+		t.Fatalf("unable to create transaction: %v", err)
+	}
+	childA, err := harness.CreateSignedTx([]spendableOutput{
+		txOutToSpendableOut(parent, 0)}, 1)
+	if err != nil {
+		t.Fatalf("unable to create transaction: %v", err)
+	}
+	childB, err := harness.CreateSignedTx([]spendableOutput{
+		txOutToSpendableOut(parent, 1)}, 1)
+	if err != nil {
+		t.Fatalf("unable to create transaction: %v", err)
+	}
+	grandchild, err := harness.CreateSignedTx([]spendableOutput{
+		txOutToSpendableOut(childA, 0), txOutToSpendableOut(childB, 0)}, 1)
+	if err != nil {
+		t.Fatalf("unable to create transaction: %v", err)
+	}
+	for _, tx := range []*btcutil.Tx{parent, childA, childB, grandchild} {
+		_, err := harness.txPool.ProcessTransaction(tx, false, false, 0)
+		if err != nil {
+			t.Fatalf("ProcessTransaction: failed to accept tx: %v",
+				err)
+		}
+	}
+
+	// statsOf returns the expected statistics of the passed transactions.
+	statsOf := func(txns ...*btcutil.Tx) TxPackageStats {
+		var stats TxPackageStats
+		for _, tx := range txns {
+			stats.Count++
+			stats.Size += int64(tx.MsgTx().SerializeSize())
+			stats.Fees += harness.txPool.pool[*tx.Hash()].Fee
+		}
+		return stats
+	}
+
+	tests := []struct {
+		name        string
+		tx          *btcutil.Tx
+		ancestors   TxPackageStats
+		descendants TxPackageStats
+	}{
+		{
+			name:        "parent",
+			tx:          parent,
+			ancestors:   statsOf(parent),
+			descendants: statsOf(parent, childA, childB, grandchild),
+		},
+		{
+			name:        "child",
+			tx:          childA,
+			ancestors:   statsOf(childA, parent),
+			descendants: statsOf(childA, grandchild),
+		},
+		{
+			name:        "grandchild",
+			tx:          grandchild,
+			ancestors:   statsOf(grandchild, childA, childB, parent),
+			descendants: statsOf(grandchild),
+		},
+	}
+
+	for _, test := range tests {
+		ancestors, descendants, err := harness.txPool.PackageStats(
+			test.tx.Hash())
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", test.name, err)
+		}
+		if ancestors != test.ancestors {
+			t.Fatalf("%s: unexpected ancestor stats - got %+v, "+
+				"want %+v", test.name, ancestors, test.ancestors)
+		}
+		if descendants != test.descendants {
+			t.Fatalf("%s: unexpected descendant stats - got %+v, "+
+				"want %+v", test.name, descendants,
+				test.descendants)
+		}
+	}
+
+	_, _, err = harness.txPool.PackageStats(&chainhash.Hash{})
+	if err == nil {
+		t.Fatalf("PackageStats: unexpected success for transaction " +
+			"not in the pool")
+	}
+}
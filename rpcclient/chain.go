@@ -13,6 +13,7 @@ import (
 	"github.com/btcsuite/btcd/btcjson"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
 )
 
 // FutureGetBestBlockHashResult is a future promise to deliver the result of a
@@ -426,6 +427,196 @@ func (c *Client) GetBlockHeaderVerbose(blockHash *chainhash.Hash) (*btcjson.GetB
 	return c.GetBlockHeaderVerboseAsync(blockHash).Receive()
 }
 
+// FutureGetMempoolAncestorsResult is a future promise to deliver the result
+// of a GetMempoolAncestorsAsync RPC invocation (or an applicable error).
+type FutureGetMempoolAncestorsResult chan *response
+
+// Receive waits for the response promised by the future and returns the hashes
+// of the in-mempool ancestors of the transaction.
+func (r FutureGetMempoolAncestorsResult) Receive() ([]*chainhash.Hash, error) {
+	return FutureGetRawMempoolResult(r).Receive()
+}
+
+// GetMempoolAncestorsAsync returns an instance of a type that can be used to
+// get the result of the RPC at some future time by invoking the Receive
+// function on the returned instance.
+//
+// See GetMempoolAncestors for the blocking version and more details.
+func (c *Client) GetMempoolAncestorsAsync(txHash *chainhash.Hash) FutureGetMempoolAncestorsResult {
+	// Not supported in HTTP POST mode.
+	if c.config.HTTPPostMode {
+		return newFutureError(ErrWebsocketsRequired)
+	}
+
+	hash := ""
+	if txHash != nil {
+		hash = txHash.String()
+	}
+
+	cmd := btcjson.NewGetMempoolAncestorsCmd(hash, btcjson.Bool(false))
+	return c.sendCmd(cmd)
+}
+
+// GetMempoolAncestors returns the hashes of the in-mempool ancestors of the
+// transaction with the given hash.
+//
+// See GetMempoolAncestorsVerbose to retrieve their mempool entries instead.
+//
+// This RPC requires the client to be running in websocket mode.
+func (c *Client) GetMempoolAncestors(txHash *chainhash.Hash) ([]*chainhash.Hash, error) {
+	return c.GetMempoolAncestorsAsync(txHash).Receive()
+}
+
+// FutureGetMempoolAncestorsVerboseResult is a future promise to deliver the
+// result of a GetMempoolAncestorsVerboseAsync RPC invocation (or an
+// applicable error).
+type FutureGetMempoolAncestorsVerboseResult chan *response
+
+// Receive waits for the response promised by the future and returns a map of
+// transaction hashes to mempool entries for the in-mempool ancestors of the
+// transaction.
+func (r FutureGetMempoolAncestorsVerboseResult) Receive() (map[string]btcjson.GetMempoolEntryResult, error) {
+	res, err := receiveFuture(r)
+	if err != nil {
+		return nil, err
+	}
+
+	// Unmarshal the result as a map of transaction hashes to their mempool
+	// entries.
+	var entries map[string]btcjson.GetMempoolEntryResult
+	err = json.Unmarshal(res, &entries)
+	if err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// GetMempoolAncestorsVerboseAsync returns an instance of a type that can be
+// used to get the result of the RPC at some future time by invoking the Receive
+// function on the returned instance.
+//
+// See GetMempoolAncestorsVerbose for the blocking version and more details.
+func (c *Client) GetMempoolAncestorsVerboseAsync(txHash *chainhash.Hash) FutureGetMempoolAncestorsVerboseResult {
+	// Not supported in HTTP POST mode.
+	if c.config.HTTPPostMode {
+		return newFutureError(ErrWebsocketsRequired)
+	}
+
+	hash := ""
+	if txHash != nil {
+		hash = txHash.String()
+	}
+
+	cmd := btcjson.NewGetMempoolAncestorsCmd(hash, btcjson.Bool(true))
+	return c.sendCmd(cmd)
+}
+
+// GetMempoolAncestorsVerbose returns a map of the transaction hashes of the
+// in-mempool ancestors of the transaction with the given hash to their mempool
+// entries.
+//
+// See GetMempoolAncestors to retrieve only the transaction hashes instead.
+//
+// This RPC requires the client to be running in websocket mode.
+func (c *Client) GetMempoolAncestorsVerbose(txHash *chainhash.Hash) (map[string]btcjson.GetMempoolEntryResult, error) {
+	return c.GetMempoolAncestorsVerboseAsync(txHash).Receive()
+}
+
+// FutureGetMempoolDescendantsResult is a future promise to deliver the result
+// of a GetMempoolDescendantsAsync RPC invocation (or an applicable error).
+type FutureGetMempoolDescendantsResult chan *response
+
+// Receive waits for the response promised by the future and returns the hashes
+// of the in-mempool descendants of the transaction.
+func (r FutureGetMempoolDescendantsResult) Receive() ([]*chainhash.Hash, error) {
+	return FutureGetRawMempoolResult(r).Receive()
+}
+
+// GetMempoolDescendantsAsync returns an instance of a type that can be used to
+// get the result of the RPC at some future time by invoking the Receive
+// function on the returned instance.
+//
+// See GetMempoolDescendants for the blocking version and more details.
+func (c *Client) GetMempoolDescendantsAsync(txHash *chainhash.Hash) FutureGetMempoolDescendantsResult {
+	// Not supported in HTTP POST mode.
+	if c.config.HTTPPostMode {
+		return newFutureError(ErrWebsocketsRequired)
+	}
+
+	hash := ""
+	if txHash != nil {
+		hash = txHash.String()
+	}
+
+	cmd := btcjson.NewGetMempoolDescendantsCmd(hash, btcjson.Bool(false))
+	return c.sendCmd(cmd)
+}
+
+// GetMempoolDescendants returns the hashes of the in-mempool descendants of the
+// transaction with the given hash.
+//
+// See GetMempoolDescendantsVerbose to retrieve their mempool entries instead.
+//
+// This RPC requires the client to be running in websocket mode.
+func (c *Client) GetMempoolDescendants(txHash *chainhash.Hash) ([]*chainhash.Hash, error) {
+	return c.GetMempoolDescendantsAsync(txHash).Receive()
+}
+
+// FutureGetMempoolDescendantsVerboseResult is a future promise to deliver the
+// result of a GetMempoolDescendantsVerboseAsync RPC invocation (or an
+// applicable error).
+type FutureGetMempoolDescendantsVerboseResult chan *response
+
+// Receive waits for the response promised by the future and returns a map of
+// transaction hashes to mempool entries for the in-mempool descendants of the
+// transaction.
+func (r FutureGetMempoolDescendantsVerboseResult) Receive() (map[string]btcjson.GetMempoolEntryResult, error) {
+	res, err := receiveFuture(r)
+	if err != nil {
+		return nil, err
+	}
+
+	// Unmarshal the result as a map of transaction hashes to their mempool
+	// entries.
+	var entries map[string]btcjson.GetMempoolEntryResult
+	err = json.Unmarshal(res, &entries)
+	if err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// GetMempoolDescendantsVerboseAsync returns an instance of a type that can be
+// used to get the result of the RPC at some future time by invoking the Receive
+// function on the returned instance.
+//
+// See GetMempoolDescendantsVerbose for the blocking version and more details.
+func (c *Client) GetMempoolDescendantsVerboseAsync(txHash *chainhash.Hash) FutureGetMempoolDescendantsVerboseResult {
+	// Not supported in HTTP POST mode.
+	if c.config.HTTPPostMode {
+		return newFutureError(ErrWebsocketsRequired)
+	}
+
+	hash := ""
+	if txHash != nil {
+		hash = txHash.String()
+	}
+
+	cmd := btcjson.NewGetMempoolDescendantsCmd(hash, btcjson.Bool(true))
+	return c.sendCmd(cmd)
+}
+
+// GetMempoolDescendantsVerbose returns a map of the transaction hashes of the
+// in-mempool descendants of the transaction with the given hash to their
+// mempool entries.
+//
+// See GetMempoolDescendants to retrieve only the transaction hashes instead.
+//
+// This RPC requires the client to be running in websocket mode.
+func (c *Client) GetMempoolDescendantsVerbose(txHash *chainhash.Hash) (map[string]btcjson.GetMempoolEntryResult, error) {
+	return c.GetMempoolDescendantsVerboseAsync(txHash).Receive()
+}
+
 // FutureGetMempoolEntryResult is a future promise to deliver the result of a
 // GetMempoolEntryAsync RPC invocation (or an applicable error).
 type FutureGetMempoolEntryResult chan *response
@@ -465,6 +656,44 @@ func (c *Client) GetMempoolEntry(txHash string) (*btcjson.GetMempoolEntryResult,
 	return c.GetMempoolEntryAsync(txHash).Receive()
 }
 
+// FutureGetMempoolInfoResult is a future promise to deliver the result of a
+// GetMempoolInfoAsync RPC invocation (or an applicable error).
+type FutureGetMempoolInfoResult chan *response
+
+// Receive waits for the response promised by the future and returns a data
+// structure with information about the state of the memory pool.
+func (r FutureGetMempoolInfoResult) Receive() (*btcjson.GetMempoolInfoResult, error) {
+	res, err := receiveFuture(r)
+	if err != nil {
+		return nil, err
+	}
+
+	// Unmarshal the result as a getmempoolinfo result object.
+	var mempoolInfoResult btcjson.GetMempoolInfoResult
+	err = json.Unmarshal(res, &mempoolInfoResult)
+	if err != nil {
+		return nil, err
+	}
+
+	return &mempoolInfoResult, nil
+}
+
+// GetMempoolInfoAsync returns an instance of a type that can be used to get the
+// result of the RPC at some future time by invoking the Receive function on the
+// returned instance.
+//
+// See GetMempoolInfo for the blocking version and more details.
+func (c *Client) GetMempoolInfoAsync() FutureGetMempoolInfoResult {
+	cmd := btcjson.NewGetMempoolInfoCmd()
+	return c.sendCmd(cmd)
+}
+
+// GetMempoolInfo returns a data structure with information about the state of
+// the memory pool.
+func (c *Client) GetMempoolInfo() (*btcjson.GetMempoolInfoResult, error) {
+	return c.GetMempoolInfoAsync().Receive()
+}
+
 // FutureGetRawMempoolResult is a future promise to deliver the result of a
 // GetRawMempoolAsync RPC invocation (or an applicable error).
 type FutureGetRawMempoolResult chan *response
@@ -589,11 +818,51 @@ func (c *Client) EstimateFeeAsync(numBlocks int64) FutureEstimateFeeResult {
 	return c.sendCmd(cmd)
 }
 
-// EstimateFee provides an estimated fee  in bitcoins per kilobyte.
+// EstimateFee provides an estimated fee  in bitcoins per kilobyte.  A fee of
+// -1 indicates the server has not observed enough blocks to make an estimate.
 func (c *Client) EstimateFee(numBlocks int64) (float64, error) {
 	return c.EstimateFeeAsync(numBlocks).Receive()
 }
 
+// FutureEstimatePriorityResult is a future promise to deliver the result of a
+// EstimatePriorityAsync RPC invocation (or an applicable error).
+type FutureEstimatePriorityResult chan *response
+
+// Receive waits for the response promised by the future and returns the
+// estimated priority provided by the server.
+func (r FutureEstimatePriorityResult) Receive() (float64, error) {
+	res, err := receiveFuture(r)
+	if err != nil {
+		return -1, err
+	}
+
+	// Unmarshal result as a float64.
+	var priority float64
+	err = json.Unmarshal(res, &priority)
+	if err != nil {
+		return -1, err
+	}
+
+	return priority, nil
+}
+
+// EstimatePriorityAsync returns an instance of a type that can be used to get
+// the result of the RPC at some future time by invoking the Receive function on
+// the returned instance.
+//
+// See EstimatePriority for the blocking version and more details.
+func (c *Client) EstimatePriorityAsync(numBlocks int64) FutureEstimatePriorityResult {
+	cmd := btcjson.NewEstimatePriorityCmd(numBlocks)
+	return c.sendCmd(cmd)
+}
+
+// EstimatePriority provides an estimate of the priority a zero-fee transaction
+// needs to begin confirmation within numBlocks blocks.  A priority of -1
+// indicates the server does not have enough data to make an estimate.
+func (c *Client) EstimatePriority(numBlocks int64) (float64, error) {
+	return c.EstimatePriorityAsync(numBlocks).Receive()
+}
+
 // FutureVerifyChainResult is a future promise to deliver the result of a
 // VerifyChainAsync, VerifyChainLevelAsyncRPC, or VerifyChainBlocksAsync
 // invocation (or an applicable error).
@@ -819,6 +1088,39 @@ func (c *Client) InvalidateBlock(blockHash *chainhash.Hash) error {
 	return c.InvalidateBlockAsync(blockHash).Receive()
 }
 
+// FuturePreciousBlockResult is a future promise to deliver the result of a
+// PreciousBlockAsync RPC invocation (or an applicable error).
+type FuturePreciousBlockResult chan *response
+
+// Receive waits for the response promised by the future and returns an error
+// if the block could not be treated as if it were received earlier.
+func (r FuturePreciousBlockResult) Receive() error {
+	_, err := receiveFuture(r)
+
+	return err
+}
+
+// PreciousBlockAsync returns an instance of a type that can be used to get the
+// result of the RPC at some future time by invoking the Receive function on the
+// returned instance.
+//
+// See PreciousBlock for the blocking version and more details.
+func (c *Client) PreciousBlockAsync(blockHash *chainhash.Hash) FuturePreciousBlockResult {
+	hash := ""
+	if blockHash != nil {
+		hash = blockHash.String()
+	}
+
+	cmd := btcjson.NewPreciousBlockCmd(hash)
+	return c.sendCmd(cmd)
+}
+
+// PreciousBlock treats a block as if it were received before others with the
+// same work, making it the preferred tip when there is a tie.
+func (c *Client) PreciousBlock(blockHash *chainhash.Hash) error {
+	return c.PreciousBlockAsync(blockHash).Receive()
+}
+
 // FutureGetCFilterResult is a future promise to deliver the result of a
 // GetCFilterAsync RPC invocation (or an applicable error).
 type FutureGetCFilterResult chan *response
@@ -927,3 +1229,382 @@ func (c *Client) GetCFilterHeader(blockHash *chainhash.Hash,
 	filterType wire.FilterType) (*wire.MsgCFHeaders, error) {
 	return c.GetCFilterHeaderAsync(blockHash, filterType).Receive()
 }
+
+// FutureGetSpentInfoResult is a future promise to deliver the result of a
+// GetSpentInfoAsync RPC invocation (or an applicable error).
+type FutureGetSpentInfoResult chan *response
+
+// Receive waits for the response promised by the future and returns the
+// transaction input which spends the requested output.
+func (r FutureGetSpentInfoResult) Receive() (*btcjson.GetSpentInfoResult, error) {
+	res, err := receiveFuture(r)
+	if err != nil {
+		return nil, err
+	}
+
+	// Unmarshal result as a getspentinfo result object.
+	var spentInfo btcjson.GetSpentInfoResult
+	err = json.Unmarshal(res, &spentInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	return &spentInfo, nil
+}
+
+// GetSpentInfoAsync returns an instance of a type that can be used to get the
+// result of the RPC at some future time by invoking the Receive function on the
+// returned instance.
+//
+// See GetSpentInfo for the blocking version and more details.
+func (c *Client) GetSpentInfoAsync(txHash *chainhash.Hash, index uint32) FutureGetSpentInfoResult {
+	// Not supported in HTTP POST mode.
+	if c.config.HTTPPostMode {
+		return newFutureError(ErrWebsocketsRequired)
+	}
+
+	hash := ""
+	if txHash != nil {
+		hash = txHash.String()
+	}
+
+	cmd := btcjson.NewGetSpentInfoCmd(hash, index)
+	return c.sendCmd(cmd)
+}
+
+// GetSpentInfo returns the transaction, input index and block height of the
+// input which spends the output identified by the passed transaction hash and
+// output index.  It requires the server to maintain a spent index.
+//
+// This RPC requires the client to be running in websocket mode.
+func (c *Client) GetSpentInfo(txHash *chainhash.Hash, index uint32) (*btcjson.GetSpentInfoResult, error) {
+	return c.GetSpentInfoAsync(txHash, index).Receive()
+}
+
+// FutureGetAddressBalanceResult is a future promise to deliver the result of a
+// GetAddressBalanceAsync RPC invocation (or an applicable error).
+type FutureGetAddressBalanceResult chan *response
+
+// Receive waits for the response promised by the future and returns the
+// balance and total received amount of the requested addresses.
+func (r FutureGetAddressBalanceResult) Receive() (*btcjson.GetAddressBalanceResult, error) {
+	res, err := receiveFuture(r)
+	if err != nil {
+		return nil, err
+	}
+
+	// Unmarshal result as a getaddressbalance result object.
+	var balance btcjson.GetAddressBalanceResult
+	err = json.Unmarshal(res, &balance)
+	if err != nil {
+		return nil, err
+	}
+
+	return &balance, nil
+}
+
+// GetAddressBalanceAsync returns an instance of a type that can be used to get
+// the result of the RPC at some future time by invoking the Receive function on
+// the returned instance.
+//
+// See GetAddressBalance for the blocking version and more details.
+func (c *Client) GetAddressBalanceAsync(addresses []btcutil.Address) FutureGetAddressBalanceResult {
+	// Not supported in HTTP POST mode.
+	if c.config.HTTPPostMode {
+		return newFutureError(ErrWebsocketsRequired)
+	}
+
+	// Convert addresses to strings.
+	addrs := make([]string, 0, len(addresses))
+	for _, addr := range addresses {
+		addrs = append(addrs, addr.EncodeAddress())
+	}
+	cmd := btcjson.NewGetAddressBalanceCmd(addrs)
+	return c.sendCmd(cmd)
+}
+
+// GetAddressBalance returns the combined balance and total amount received by
+// the passed addresses.  It requires the server to maintain an address index.
+//
+// This RPC requires the client to be running in websocket mode.
+func (c *Client) GetAddressBalance(addresses []btcutil.Address) (*btcjson.GetAddressBalanceResult, error) {
+	return c.GetAddressBalanceAsync(addresses).Receive()
+}
+
+// FutureGetAddressUTXOsResult is a future promise to deliver the result of a
+// GetAddressUTXOsAsync RPC invocation (or an applicable error).
+type FutureGetAddressUTXOsResult chan *response
+
+// Receive waits for the response promised by the future and returns the
+// unspent outputs paying to the requested addresses.
+func (r FutureGetAddressUTXOsResult) Receive() ([]btcjson.GetAddressUTXOsResult, error) {
+	res, err := receiveFuture(r)
+	if err != nil {
+		return nil, err
+	}
+
+	// Unmarshal result as an array of getaddressutxos result objects.
+	var utxos []btcjson.GetAddressUTXOsResult
+	err = json.Unmarshal(res, &utxos)
+	if err != nil {
+		return nil, err
+	}
+
+	return utxos, nil
+}
+
+// GetAddressUTXOsAsync returns an instance of a type that can be used to get
+// the result of the RPC at some future time by invoking the Receive function on
+// the returned instance.
+//
+// See GetAddressUTXOs for the blocking version and more details.
+func (c *Client) GetAddressUTXOsAsync(addresses []btcutil.Address) FutureGetAddressUTXOsResult {
+	// Not supported in HTTP POST mode.
+	if c.config.HTTPPostMode {
+		return newFutureError(ErrWebsocketsRequired)
+	}
+
+	// Convert addresses to strings.
+	addrs := make([]string, 0, len(addresses))
+	for _, addr := range addresses {
+		addrs = append(addrs, addr.EncodeAddress())
+	}
+	cmd := btcjson.NewGetAddressUTXOsCmd(addrs, nil)
+	return c.sendCmd(cmd)
+}
+
+// GetAddressUTXOs returns the unspent outputs paying to the passed addresses.
+// It requires the server to maintain an address index.
+//
+// This RPC requires the client to be running in websocket mode.
+func (c *Client) GetAddressUTXOs(addresses []btcutil.Address) ([]btcjson.GetAddressUTXOsResult, error) {
+	return c.GetAddressUTXOsAsync(addresses).Receive()
+}
+
+// FutureGetAddressTxidsResult is a future promise to deliver the result of a
+// GetAddressTxidsAsync RPC invocation (or an applicable error).
+type FutureGetAddressTxidsResult chan *response
+
+// Receive waits for the response promised by the future and returns the hashes
+// of the transactions involving the requested addresses.
+func (r FutureGetAddressTxidsResult) Receive() ([]string, error) {
+	res, err := receiveFuture(r)
+	if err != nil {
+		return nil, err
+	}
+
+	// Unmarshal result as an array of strings.
+	var txids []string
+	err = json.Unmarshal(res, &txids)
+	if err != nil {
+		return nil, err
+	}
+
+	return txids, nil
+}
+
+// GetAddressTxidsAsync returns an instance of a type that can be used to get
+// the result of the RPC at some future time by invoking the Receive function on
+// the returned instance.
+//
+// See GetAddressTxids for the blocking version and more details.
+func (c *Client) GetAddressTxidsAsync(addresses []btcutil.Address, start, end *int32) FutureGetAddressTxidsResult {
+	// Not supported in HTTP POST mode.
+	if c.config.HTTPPostMode {
+		return newFutureError(ErrWebsocketsRequired)
+	}
+
+	// Convert addresses to strings.
+	addrs := make([]string, 0, len(addresses))
+	for _, addr := range addresses {
+		addrs = append(addrs, addr.EncodeAddress())
+	}
+	cmd := btcjson.NewGetAddressTxidsCmd(addrs, start, end)
+	return c.sendCmd(cmd)
+}
+
+// GetAddressTxids returns the hashes of the transactions involving the passed
+// addresses.  The start and end heights optionally limit the search to a range
+// of blocks and may be nil.  It requires the server to maintain an address
+// index.
+//
+// This RPC requires the client to be running in websocket mode.
+func (c *Client) GetAddressTxids(addresses []btcutil.Address, start, end *int32) ([]string, error) {
+	return c.GetAddressTxidsAsync(addresses, start, end).Receive()
+}
+
+// FutureGetAddressDeltasResult is a future promise to deliver the result of a
+// GetAddressDeltasAsync RPC invocation (or an applicable error).
+type FutureGetAddressDeltasResult chan *response
+
+// Receive waits for the response promised by the future and returns the
+// balance changes of the requested addresses.
+func (r FutureGetAddressDeltasResult) Receive() ([]btcjson.GetAddressDeltasResult, error) {
+	res, err := receiveFuture(r)
+	if err != nil {
+		return nil, err
+	}
+
+	// Unmarshal result as an array of getaddressdeltas result objects.
+	var deltas []btcjson.GetAddressDeltasResult
+	err = json.Unmarshal(res, &deltas)
+	if err != nil {
+		return nil, err
+	}
+
+	return deltas, nil
+}
+
+// GetAddressDeltasAsync returns an instance of a type that can be used to get
+// the result of the RPC at some future time by invoking the Receive function on
+// the returned instance.
+//
+// See GetAddressDeltas for the blocking version and more details.
+func (c *Client) GetAddressDeltasAsync(addresses []btcutil.Address, start, end *int32) FutureGetAddressDeltasResult {
+	// Not supported in HTTP POST mode.
+	if c.config.HTTPPostMode {
+		return newFutureError(ErrWebsocketsRequired)
+	}
+
+	// Convert addresses to strings.
+	addrs := make([]string, 0, len(addresses))
+	for _, addr := range addresses {
+		addrs = append(addrs, addr.EncodeAddress())
+	}
+	cmd := btcjson.NewGetAddressDeltasCmd(addrs, start, end, nil)
+	return c.sendCmd(cmd)
+}
+
+// GetAddressDeltas returns every change to the balances of the passed
+// addresses.  The start and end heights optionally limit the search to a range
+// of blocks and may be nil.  It requires the server to maintain an address
+// index.
+//
+// This RPC requires the client to be running in websocket mode.
+func (c *Client) GetAddressDeltas(addresses []btcutil.Address, start, end *int32) ([]btcjson.GetAddressDeltasResult, error) {
+	return c.GetAddressDeltasAsync(addresses, start, end).Receive()
+}
+
+// FutureGetAddressMempoolResult is a future promise to deliver the result of a
+// GetAddressMempoolAsync RPC invocation (or an applicable error).
+type FutureGetAddressMempoolResult chan *response
+
+// Receive waits for the response promised by the future and returns the
+// balance changes of the requested addresses caused by memory pool
+// transactions.
+func (r FutureGetAddressMempoolResult) Receive() ([]btcjson.GetAddressMempoolResult, error) {
+	res, err := receiveFuture(r)
+	if err != nil {
+		return nil, err
+	}
+
+	// Unmarshal result as an array of getaddressmempool result objects.
+	var entries []btcjson.GetAddressMempoolResult
+	err = json.Unmarshal(res, &entries)
+	if err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+// GetAddressMempoolAsync returns an instance of a type that can be used to get
+// the result of the RPC at some future time by invoking the Receive function on
+// the returned instance.
+//
+// See GetAddressMempool for the blocking version and more details.
+func (c *Client) GetAddressMempoolAsync(addresses []btcutil.Address) FutureGetAddressMempoolResult {
+	// Not supported in HTTP POST mode.
+	if c.config.HTTPPostMode {
+		return newFutureError(ErrWebsocketsRequired)
+	}
+
+	// Convert addresses to strings.
+	addrs := make([]string, 0, len(addresses))
+	for _, addr := range addresses {
+		addrs = append(addrs, addr.EncodeAddress())
+	}
+	cmd := btcjson.NewGetAddressMempoolCmd(addrs)
+	return c.sendCmd(cmd)
+}
+
+// GetAddressMempool returns the changes to the balances of the passed addresses
+// caused by transactions in the memory pool.  It requires the server to
+// maintain an address index.
+//
+// This RPC requires the client to be running in websocket mode.
+func (c *Client) GetAddressMempool(addresses []btcutil.Address) ([]btcjson.GetAddressMempoolResult, error) {
+	return c.GetAddressMempoolAsync(addresses).Receive()
+}
+
+// FutureWaitForBlockResult is a future promise to deliver the result of a
+// WaitForNewBlockAsync or WaitForBlockHeightAsync RPC invocation (or an
+// applicable error).
+type FutureWaitForBlockResult chan *response
+
+// Receive waits for the response promised by the future and returns the hash
+// and height of the best block once the wait has completed.
+func (r FutureWaitForBlockResult) Receive() (*btcjson.WaitForBlockResult, error) {
+	res, err := receiveFuture(r)
+	if err != nil {
+		return nil, err
+	}
+
+	// Unmarshal result as a waitfornewblock or waitforblockheight result
+	// object.
+	var block btcjson.WaitForBlockResult
+	err = json.Unmarshal(res, &block)
+	if err != nil {
+		return nil, err
+	}
+
+	return &block, nil
+}
+
+// WaitForNewBlockAsync returns an instance of a type that can be used to get
+// the result of the RPC at some future time by invoking the Receive function on
+// the returned instance.
+//
+// See WaitForNewBlock for the blocking version and more details.
+func (c *Client) WaitForNewBlockAsync(timeout int) FutureWaitForBlockResult {
+	// Not supported in HTTP POST mode.
+	if c.config.HTTPPostMode {
+		return newFutureError(ErrWebsocketsRequired)
+	}
+
+	cmd := btcjson.NewWaitForNewBlockCmd(&timeout)
+	return c.sendCmd(cmd)
+}
+
+// WaitForNewBlock waits until a new block is connected to the best chain or the
+// timeout, in milliseconds, elapses and returns the hash and height of the best
+// block at that time.  A timeout of zero waits indefinitely.
+//
+// This RPC requires the client to be running in websocket mode.
+func (c *Client) WaitForNewBlock(timeout int) (*btcjson.WaitForBlockResult, error) {
+	return c.WaitForNewBlockAsync(timeout).Receive()
+}
+
+// WaitForBlockHeightAsync returns an instance of a type that can be used to get
+// the result of the RPC at some future time by invoking the Receive function on
+// the returned instance.
+//
+// See WaitForBlockHeight for the blocking version and more details.
+func (c *Client) WaitForBlockHeightAsync(height int32, timeout int) FutureWaitForBlockResult {
+	// Not supported in HTTP POST mode.
+	if c.config.HTTPPostMode {
+		return newFutureError(ErrWebsocketsRequired)
+	}
+
+	cmd := btcjson.NewWaitForBlockHeightCmd(height, &timeout)
+	return c.sendCmd(cmd)
+}
+
+// WaitForBlockHeight waits until the best chain reaches at least the passed
+// height or the timeout, in milliseconds, elapses and returns the hash and
+// height of the best block at that time.  A timeout of zero waits indefinitely.
+//
+// This RPC requires the client to be running in websocket mode.
+func (c *Client) WaitForBlockHeight(height int32, timeout int) (*btcjson.WaitForBlockResult, error) {
+	return c.WaitForBlockHeightAsync(height, timeout).Receive()
+}
@@ -10,6 +10,7 @@ import (
 	"encoding/base64"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 
 	"github.com/btcsuite/btcd/btcjson"
@@ -18,6 +19,13 @@ import (
 	"github.com/btcsuite/btcutil"
 )
 
+var (
+	// ErrUnknownNet is an error to describe the condition where the server
+	// replied to a getcurrentnet request with a network magic that does not
+	// match any of the known bitcoin networks.
+	ErrUnknownNet = errors.New("unknown bitcoin network")
+)
+
 // FutureDebugLevelResult is a future promise to deliver the result of a
 // DebugLevelAsync RPC invocation (or an applicable error).
 type FutureDebugLevelResult chan *response
@@ -199,7 +207,8 @@ func (c *Client) GetBestBlock() (*chainhash.Hash, int32, error) {
 type FutureGetCurrentNetResult chan *response
 
 // Receive waits for the response promised by the future and returns the network
-// the server is running on.
+// the server is running on.  ErrUnknownNet is returned along with the reported
+// network when it is not one of the known bitcoin networks.
 func (r FutureGetCurrentNetResult) Receive() (wire.BitcoinNet, error) {
 	res, err := receiveFuture(r)
 	if err != nil {
@@ -213,7 +222,12 @@ func (r FutureGetCurrentNetResult) Receive() (wire.BitcoinNet, error) {
 		return 0, err
 	}
 
-	return wire.BitcoinNet(net), nil
+	switch btcnet := wire.BitcoinNet(net); btcnet {
+	case wire.MainNet, wire.TestNet, wire.TestNet3, wire.SimNet:
+		return btcnet, nil
+	default:
+		return btcnet, ErrUnknownNet
+	}
 }
 
 // GetCurrentNetAsync returns an instance of a type that can be used to get the
@@ -0,0 +1,68 @@
+// Copyright (c) 2014-2017 The btcsuite developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package rpcclient
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/btcsuite/btcd/wire"
+)
+
+// TestGetCurrentNetReceive ensures the result of a getcurrentnet request is
+// decoded to the reported network and that ErrUnknownNet is returned for a
+// network magic which does not match any of the known bitcoin networks.
+func TestGetCurrentNetReceive(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		result string
+		want   wire.BitcoinNet
+		err    error
+	}{
+		{
+			name:   "mainnet",
+			result: strconv.FormatUint(uint64(wire.MainNet), 10),
+			want:   wire.MainNet,
+		},
+		{
+			name:   "testnet3",
+			result: strconv.FormatUint(uint64(wire.TestNet3), 10),
+			want:   wire.TestNet3,
+		},
+		{
+			name:   "unknown magic",
+			result: "3735928559",
+			want:   wire.BitcoinNet(0xdeadbeef),
+			err:    ErrUnknownNet,
+		},
+	}
+
+	t.Logf("Running %d tests", len(tests))
+	for i, test := range tests {
+		future := make(FutureGetCurrentNetResult, 1)
+		future <- &response{result: []byte(test.result)}
+		got, err := future.Receive()
+		if err != test.err {
+			t.Errorf("Test #%d (%s) unexpected error - got %v, "+
+				"want %v", i, test.name, err, test.err)
+			continue
+		}
+		if got != test.want {
+			t.Errorf("Test #%d (%s) unexpected network - got %v, "+
+				"want %v", i, test.name, got, test.want)
+		}
+	}
+
+	// A malformed reply is reported as a decode error rather than an
+	// unknown network.
+	future := make(FutureGetCurrentNetResult, 1)
+	future <- &response{result: []byte(`"mainnet"`)}
+	if _, err := future.Receive(); err == nil || err == ErrUnknownNet {
+		t.Errorf("Receive: unexpected error for malformed reply: %v",
+			err)
+	}
+}
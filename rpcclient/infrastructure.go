@@ -24,6 +24,7 @@ import (
 	"time"
 
 	"github.com/btcsuite/btcd/btcjson"
+	"github.com/btcsuite/btcutil"
 	"github.com/btcsuite/go-socks/socks"
 	"github.com/btcsuite/websocket"
 )
@@ -258,6 +259,17 @@ func (c *Client) trackRegisteredNtfns(cmd interface{}) {
 		for _, addr := range bcmd.Addresses {
 			c.ntfnState.notifyReceived[addr] = struct{}{}
 		}
+
+	case *btcjson.NotifyWalletCmd:
+		for _, addr := range bcmd.Addresses {
+			c.ntfnState.notifyReceived[addr] = struct{}{}
+		}
+		for _, op := range bcmd.OutPoints {
+			c.ntfnState.notifySpent[op] = struct{}{}
+		}
+
+	case *btcjson.ClearNotificationsCmd:
+		c.ntfnState = newNotificationState()
 	}
 }
 
@@ -798,6 +810,26 @@ func receiveFuture(f chan *response) ([]byte, error) {
 	return r.result, r.err
 }
 
+// amountToSatoshis converts the passed amount, expressed in bitcoin as
+// returned by the server, to satoshi.  It is only meant for amounts which can
+// never be negative, such as the total output value of a transaction, since
+// it rejects negative amounts in addition to those btcutil.NewAmount rejects
+// and those too large to represent.  Balances, which may be negative, must
+// use btcutil.NewAmount directly.
+func amountToSatoshis(f float64) (int64, error) {
+	if f < 0 {
+		return 0, fmt.Errorf("negative bitcoin amount %v", f)
+	}
+	if f*btcutil.SatoshiPerBitcoin >= math.MaxInt64 {
+		return 0, fmt.Errorf("bitcoin amount %v is too large", f)
+	}
+	amt, err := btcutil.NewAmount(f)
+	if err != nil {
+		return 0, err
+	}
+	return int64(amt), nil
+}
+
 // sendPost sends the passed request to the server by issuing an HTTP POST
 // request using the provided response channel for the reply.  Typically a new
 // connection is opened and closed for each command when using this method,
@@ -0,0 +1,92 @@
+// Copyright (c) 2014-2017 The btcsuite developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package rpcclient
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+)
+
+// TestAmountToSatoshis ensures floating point amounts are converted to the
+// exact number of satoshi and that invalid amounts are rejected.
+func TestAmountToSatoshis(t *testing.T) {
+	tests := []struct {
+		name    string
+		amount  float64
+		want    int64
+		wantErr bool
+	}{
+		{name: "zero", amount: 0, want: 0},
+		{name: "one satoshi", amount: 0.00000001, want: 1},
+		{name: "one bitcoin", amount: 1, want: 100000000},
+		{name: "0.1+0.2", amount: 0.1 + 0.2, want: 30000000},
+		{name: "0.3-0.1", amount: 0.3 - 0.1, want: 20000000},
+		{name: "1.15", amount: 1.15, want: 115000000},
+		{name: "round up", amount: 0.000000016, want: 2},
+		{name: "round down", amount: 0.000000014, want: 1},
+		{name: "21 million", amount: 21e6, want: 2100000000000000},
+		{name: "negative", amount: -0.1, wantErr: true},
+		{name: "NaN", amount: math.NaN(), wantErr: true},
+		{name: "+Inf", amount: math.Inf(1), wantErr: true},
+		{name: "-Inf", amount: math.Inf(-1), wantErr: true},
+		{name: "overflow", amount: math.MaxFloat64, wantErr: true},
+	}
+
+	t.Logf("Running %d tests", len(tests))
+	for i, test := range tests {
+		got, err := amountToSatoshis(test.amount)
+		if (err != nil) != test.wantErr {
+			t.Errorf("Test #%d (%s) unexpected error: got %v, "+
+				"want error %v", i, test.name, err,
+				test.wantErr)
+			continue
+		}
+		if got != test.want {
+			t.Errorf("Test #%d (%s) unexpected satoshi - got %d, "+
+				"want %d", i, test.name, got, test.want)
+		}
+	}
+}
+
+// TestNegativeBalances ensures the balances reported by the server, which may
+// legitimately be negative for an account, decode to negative amounts rather
+// than failing.
+func TestNegativeBalances(t *testing.T) {
+	t.Parallel()
+
+	balanceFuture := make(FutureGetBalanceResult, 1)
+	balanceFuture <- &response{result: []byte(`-0.5`)}
+	balance, err := balanceFuture.Receive()
+	if err != nil {
+		t.Fatalf("GetBalance: unexpected error: %v", err)
+	}
+	if balance != -50000000 {
+		t.Fatalf("GetBalance: unexpected balance - got %d, want %d",
+			balance, -50000000)
+	}
+
+	accountsFuture := make(FutureListAccountsResult, 1)
+	accountsFuture <- &response{result: []byte(`{"":1,"acct":-0.25}`)}
+	accounts, err := accountsFuture.Receive()
+	if err != nil {
+		t.Fatalf("ListAccounts: unexpected error: %v", err)
+	}
+	if accounts["acct"] != -25000000 {
+		t.Fatalf("ListAccounts: unexpected balance - got %d, want %d",
+			accounts["acct"], -25000000)
+	}
+
+	params := []json.RawMessage{[]byte(`"acct"`), []byte(`-0.25`),
+		[]byte(`true`)}
+	_, bal, _, err := parseAccountBalanceNtfnParams(params)
+	if err != nil {
+		t.Fatalf("accountbalance: unexpected error: %v", err)
+	}
+	if bal != -25000000 {
+		t.Fatalf("accountbalance: unexpected balance - got %d, want %d",
+			bal, -25000000)
+	}
+}
@@ -181,6 +181,43 @@ func (c *Client) GetConnectionCount() (int64, error) {
 	return c.GetConnectionCountAsync().Receive()
 }
 
+// FutureUptimeResult is a future promise to deliver the result of an
+// UptimeAsync RPC invocation (or an applicable error).
+type FutureUptimeResult chan *response
+
+// Receive waits for the response promised by the future and returns the number
+// of seconds the server has been running.
+func (r FutureUptimeResult) Receive() (int64, error) {
+	res, err := receiveFuture(r)
+	if err != nil {
+		return 0, err
+	}
+
+	// Unmarshal result as an int64.
+	var uptime int64
+	err = json.Unmarshal(res, &uptime)
+	if err != nil {
+		return 0, err
+	}
+
+	return uptime, nil
+}
+
+// UptimeAsync returns an instance of a type that can be used to get the result
+// of the RPC at some future time by invoking the Receive function on the
+// returned instance.
+//
+// See Uptime for the blocking version and more details.
+func (c *Client) UptimeAsync() FutureUptimeResult {
+	cmd := btcjson.NewUptimeCmd()
+	return c.sendCmd(cmd)
+}
+
+// Uptime returns the number of seconds the server has been running.
+func (c *Client) Uptime() (int64, error) {
+	return c.UptimeAsync().Receive()
+}
+
 // FuturePingResult is a future promise to deliver the result of a PingAsync RPC
 // invocation (or an applicable error).
 type FuturePingResult chan *response
@@ -247,6 +284,87 @@ func (c *Client) GetPeerInfo() ([]btcjson.GetPeerInfoResult, error) {
 	return c.GetPeerInfoAsync().Receive()
 }
 
+// FutureListBannedResult is a future promise to deliver the result of a
+// ListBannedAsync RPC invocation (or an applicable error).
+type FutureListBannedResult chan *response
+
+// Receive waits for the response promised by the future and returns the
+// subnets which are currently banned.
+func (r FutureListBannedResult) Receive() ([]btcjson.ListBannedResult, error) {
+	res, err := receiveFuture(r)
+	if err != nil {
+		return nil, err
+	}
+
+	// Unmarshal result as an array of listbanned result objects.
+	var banned []btcjson.ListBannedResult
+	err = json.Unmarshal(res, &banned)
+	if err != nil {
+		return nil, err
+	}
+
+	return banned, nil
+}
+
+// ListBannedAsync returns an instance of a type that can be used to get the
+// result of the RPC at some future time by invoking the Receive function on the
+// returned instance.
+//
+// See ListBanned for the blocking version and more details.
+func (c *Client) ListBannedAsync() FutureListBannedResult {
+	// Not supported in HTTP POST mode.
+	if c.config.HTTPPostMode {
+		return newFutureError(ErrWebsocketsRequired)
+	}
+
+	cmd := btcjson.NewListBannedCmd()
+	return c.sendCmd(cmd)
+}
+
+// ListBanned returns the subnets which are currently banned.
+//
+// This RPC requires the client to be running in websocket mode.
+func (c *Client) ListBanned() ([]btcjson.ListBannedResult, error) {
+	return c.ListBannedAsync().Receive()
+}
+
+// FutureGetNetworkInfoResult is a future promise to deliver the result of a
+// GetNetworkInfoAsync RPC invocation (or an applicable error).
+type FutureGetNetworkInfoResult chan *response
+
+// Receive waits for the response promised by the future and returns data about
+// the current network.
+func (r FutureGetNetworkInfoResult) Receive() (*btcjson.GetNetworkInfoResult, error) {
+	res, err := receiveFuture(r)
+	if err != nil {
+		return nil, err
+	}
+
+	// Unmarshal result as a getnetworkinfo result object.
+	var networkInfo btcjson.GetNetworkInfoResult
+	err = json.Unmarshal(res, &networkInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	return &networkInfo, nil
+}
+
+// GetNetworkInfoAsync returns an instance of a type that can be used to get the
+// result of the RPC at some future time by invoking the Receive function on the
+// returned instance.
+//
+// See GetNetworkInfo for the blocking version and more details.
+func (c *Client) GetNetworkInfoAsync() FutureGetNetworkInfoResult {
+	cmd := btcjson.NewGetNetworkInfoCmd()
+	return c.sendCmd(cmd)
+}
+
+// GetNetworkInfo returns data about the current network.
+func (c *Client) GetNetworkInfo() (*btcjson.GetNetworkInfoResult, error) {
+	return c.GetNetworkInfoAsync().Receive()
+}
+
 // FutureGetNetTotalsResult is a future promise to deliver the result of a
 // GetNetTotalsAsync RPC invocation (or an applicable error).
 type FutureGetNetTotalsResult chan *response
@@ -283,3 +401,45 @@ func (c *Client) GetNetTotalsAsync() FutureGetNetTotalsResult {
 func (c *Client) GetNetTotals() (*btcjson.GetNetTotalsResult, error) {
 	return c.GetNetTotalsAsync().Receive()
 }
+
+// FutureHelpResult is a future promise to deliver the result of a HelpAsync
+// RPC invocation (or an applicable error).
+type FutureHelpResult chan *response
+
+// Receive waits for the response promised by the future and returns either the
+// list of supported methods or the help text for a specific method.
+func (r FutureHelpResult) Receive() (string, error) {
+	res, err := receiveFuture(r)
+	if err != nil {
+		return "", err
+	}
+
+	// Unmarshal the result as a string.
+	var help string
+	err = json.Unmarshal(res, &help)
+	if err != nil {
+		return "", err
+	}
+
+	return help, nil
+}
+
+// HelpAsync returns an instance of a type that can be used to get the result
+// of the RPC at some future time by invoking the Receive function on the
+// returned instance.
+//
+// See Help for the blocking version and more details.
+func (c *Client) HelpAsync(command string) FutureHelpResult {
+	var cmdName *string
+	if command != "" {
+		cmdName = &command
+	}
+	cmd := btcjson.NewHelpCmd(cmdName)
+	return c.sendCmd(cmd)
+}
+
+// Help returns the list of methods supported by the server when the passed
+// command is empty, or the help text for the passed command otherwise.
+func (c *Client) Help(command string) (string, error) {
+	return c.HelpAsync(command).Receive()
+}
@@ -179,6 +179,11 @@ type NotificationHandlers struct {
 	// made to register for the notification and the function is non-nil.
 	OnTxAcceptedVerbose func(txDetails *btcjson.TxRawResult)
 
+	// OnTxRejected is invoked when a transaction is rejected by the memory
+	// pool along with the reason it was rejected.  It will only be invoked
+	// if the function is non-nil.
+	OnTxRejected func(hash *chainhash.Hash, reason string)
+
 	// OnBtcdConnected is invoked when a wallet connects or disconnects from
 	// btcd.
 	//
@@ -409,6 +414,23 @@ func (c *Client) handleNotification(ntfn *rawNotification) {
 
 		c.ntfnHandlers.OnTxAcceptedVerbose(rawTx)
 
+	// OnTxRejected
+	case btcjson.TxRejectedNtfnMethod:
+		// Ignore the notification if the client is not interested in
+		// it.
+		if c.ntfnHandlers.OnTxRejected == nil {
+			return
+		}
+
+		hash, reason, err := parseTxRejectedNtfnParams(ntfn.Params)
+		if err != nil {
+			log.Warnf("Received invalid tx rejected "+
+				"notification: %v", err)
+			return
+		}
+
+		c.ntfnHandlers.OnTxRejected(hash, reason)
+
 	// OnBtcdConnected
 	case btcjson.BtcdConnectedNtfnMethod:
 		// Ignore the notification if the client is not interested in
@@ -739,10 +761,11 @@ func parseTxAcceptedNtfnParams(params []json.RawMessage) (*chainhash.Hash,
 	}
 
 	// Bounds check amount.
-	amt, err := btcutil.NewAmount(famt)
+	sat, err := amountToSatoshis(famt)
 	if err != nil {
 		return nil, 0, err
 	}
+	amt := btcutil.Amount(sat)
 
 	// Decode string encoding of transaction sha.
 	txHash, err := chainhash.NewHashFromStr(txHashStr)
@@ -753,6 +776,38 @@ func parseTxAcceptedNtfnParams(params []json.RawMessage) (*chainhash.Hash,
 	return txHash, amt, nil
 }
 
+// parseTxRejectedNtfnParams parses out the transaction hash and rejection
+// reason from the parameters of a txrejected notification.
+func parseTxRejectedNtfnParams(params []json.RawMessage) (*chainhash.Hash,
+	string, error) {
+
+	if len(params) != 2 {
+		return nil, "", wrongNumParams(len(params))
+	}
+
+	// Unmarshal first parameter as a string.
+	var txHashStr string
+	err := json.Unmarshal(params[0], &txHashStr)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// Unmarshal second parameter as a string.
+	var reason string
+	err = json.Unmarshal(params[1], &reason)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// Decode string encoding of transaction sha.
+	txHash, err := chainhash.NewHashFromStr(txHashStr)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return txHash, reason, nil
+}
+
 // parseTxAcceptedVerboseNtfnParams parses out details about a raw transaction
 // from the parameters of a txacceptedverbose notification.
 func parseTxAcceptedVerboseNtfnParams(params []json.RawMessage) (*btcjson.TxRawResult,
@@ -902,6 +957,49 @@ func (c *Client) NotifyBlocks() error {
 	return c.NotifyBlocksAsync().Receive()
 }
 
+// FutureClearNotificationsResult is a future promise to deliver the result of a
+// ClearNotificationsAsync RPC invocation (or an applicable error).
+type FutureClearNotificationsResult chan *response
+
+// Receive waits for the response promised by the future and returns an error
+// if the notifications could not be cleared.
+func (r FutureClearNotificationsResult) Receive() error {
+	_, err := receiveFuture(r)
+	return err
+}
+
+// ClearNotificationsAsync returns an instance of a type that can be used to get
+// the result of the RPC at some future time by invoking the Receive function on
+// the returned instance.
+//
+// See ClearNotifications for the blocking version and more details.
+//
+// NOTE: This is a btcd extension and requires a websocket connection.
+func (c *Client) ClearNotificationsAsync() FutureClearNotificationsResult {
+	// Not supported in HTTP POST mode.
+	if c.config.HTTPPostMode {
+		return newFutureError(ErrWebsocketsRequired)
+	}
+
+	// Ignore the notification if the client is not interested in
+	// notifications.
+	if c.ntfnHandlers == nil {
+		return newNilFutureResult()
+	}
+
+	cmd := btcjson.NewClearNotificationsCmd()
+	return c.sendCmd(cmd)
+}
+
+// ClearNotifications cancels every notification previously registered by the
+// client, along with any loaded transaction filter, so none of them are
+// re-established on reconnect.
+//
+// NOTE: This is a btcd extension and requires a websocket connection.
+func (c *Client) ClearNotifications() error {
+	return c.ClearNotificationsAsync().Receive()
+}
+
 // FutureNotifySpentResult is a future promise to deliver the result of a
 // NotifySpentAsync RPC invocation (or an applicable error).
 //
@@ -1123,6 +1221,63 @@ func (c *Client) NotifyReceived(addresses []btcutil.Address) error {
 	return c.NotifyReceivedAsync(addresses).Receive()
 }
 
+// FutureNotifyWalletResult is a future promise to deliver the result of a
+// NotifyWalletAsync RPC invocation (or an applicable error).
+type FutureNotifyWalletResult chan *response
+
+// Receive waits for the response promised by the future and returns an error
+// if the registration was not successful.
+func (r FutureNotifyWalletResult) Receive() error {
+	_, err := receiveFuture(r)
+	return err
+}
+
+// NotifyWalletAsync returns an instance of a type that can be used to get the
+// result of the RPC at some future time by invoking the Receive function on
+// the returned instance.
+//
+// See NotifyWallet for the blocking version and more details.
+//
+// NOTE: This is a btcd extension and requires a websocket connection.
+func (c *Client) NotifyWalletAsync(addresses []btcutil.Address, outpoints []*wire.OutPoint) FutureNotifyWalletResult {
+	// Not supported in HTTP POST mode.
+	if c.config.HTTPPostMode {
+		return newFutureError(ErrWebsocketsRequired)
+	}
+
+	// Ignore the notification if the client is not interested in
+	// notifications.
+	if c.ntfnHandlers == nil {
+		return newNilFutureResult()
+	}
+
+	addrs := make([]string, 0, len(addresses))
+	for _, addr := range addresses {
+		addrs = append(addrs, addr.String())
+	}
+	ops := make([]btcjson.OutPoint, 0, len(outpoints))
+	for _, outpoint := range outpoints {
+		ops = append(ops, newOutPointFromWire(outpoint))
+	}
+	cmd := btcjson.NewNotifyWalletCmd(addrs, ops)
+	return c.sendCmd(cmd)
+}
+
+// NotifyWallet registers the client to receive the notifications of both
+// NotifyReceived and NotifySpent in a single request, avoiding any ordering
+// races between the two registrations.  The notifications are delivered to the
+// notification handlers associated with the client.  Calling this function has
+// no effect if there are no notification handlers and will result in an error
+// if the client is configured to run in HTTP POST mode.
+//
+// The notifications delivered as a result of this call will be via one of
+// *OnRecvTx or OnRedeemingTx.
+//
+// NOTE: This is a btcd extension and requires a websocket connection.
+func (c *Client) NotifyWallet(addresses []btcutil.Address, outpoints []*wire.OutPoint) error {
+	return c.NotifyWalletAsync(addresses, outpoints).Receive()
+}
+
 // FutureRescanResult is a future promise to deliver the result of a RescanAsync
 // or RescanEndHeightAsync RPC invocation (or an applicable error).
 //
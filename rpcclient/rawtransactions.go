@@ -257,6 +257,132 @@ func (c *Client) CreateRawTransaction(inputs []btcjson.TransactionInput,
 	return c.CreateRawTransactionAsync(inputs, amounts, lockTime).Receive()
 }
 
+// FutureCombineRawTransactionResult is a future promise to deliver the result
+// of a CombineRawTransactionAsync RPC invocation (or an applicable error).
+type FutureCombineRawTransactionResult chan *response
+
+// Receive waits for the response promised by the future and returns the
+// transaction with the signatures of all of the partially signed transactions
+// combined.
+func (r FutureCombineRawTransactionResult) Receive() (*wire.MsgTx, error) {
+	res, err := receiveFuture(r)
+	if err != nil {
+		return nil, err
+	}
+
+	// Unmarshal result as a string.
+	var txHex string
+	err = json.Unmarshal(res, &txHex)
+	if err != nil {
+		return nil, err
+	}
+
+	// Decode the serialized transaction hex to raw bytes.
+	serializedTx, err := hex.DecodeString(txHex)
+	if err != nil {
+		return nil, err
+	}
+
+	// Deserialize the transaction and return it.
+	var msgTx wire.MsgTx
+	if err := msgTx.Deserialize(bytes.NewReader(serializedTx)); err != nil {
+		return nil, err
+	}
+	return &msgTx, nil
+}
+
+// CombineRawTransactionAsync returns an instance of a type that can be used to
+// get the result of the RPC at some future time by invoking the Receive
+// function on the returned instance.
+//
+// See CombineRawTransaction for the blocking version and more details.
+func (c *Client) CombineRawTransactionAsync(txs []*wire.MsgTx) FutureCombineRawTransactionResult {
+	// Not supported in HTTP POST mode.
+	if c.config.HTTPPostMode {
+		return newFutureError(ErrWebsocketsRequired)
+	}
+
+	// Serialize the transactions and convert them to hex strings.
+	txHexes := make([]string, 0, len(txs))
+	for _, tx := range txs {
+		buf := bytes.NewBuffer(make([]byte, 0, tx.SerializeSize()))
+		if err := tx.Serialize(buf); err != nil {
+			return newFutureError(err)
+		}
+		txHexes = append(txHexes, hex.EncodeToString(buf.Bytes()))
+	}
+
+	cmd := btcjson.NewCombineRawTransactionCmd(txHexes)
+	return c.sendCmd(cmd)
+}
+
+// CombineRawTransaction combines the signatures of several partially signed
+// versions of the same transaction into a single transaction.
+//
+// This RPC requires the client to be running in websocket mode.
+func (c *Client) CombineRawTransaction(txs []*wire.MsgTx) (*wire.MsgTx, error) {
+	return c.CombineRawTransactionAsync(txs).Receive()
+}
+
+// FutureFundRawTransactionResult is a future promise to deliver the result of a
+// FundRawTransactionAsync RPC invocation (or an applicable error).
+type FutureFundRawTransactionResult chan *response
+
+// Receive waits for the response promised by the future and returns the funded
+// transaction along with its fee and the position of its change output.
+func (r FutureFundRawTransactionResult) Receive() (*btcjson.FundRawTransactionResult, error) {
+	res, err := receiveFuture(r)
+	if err != nil {
+		return nil, err
+	}
+
+	// Unmarshal result as a fundrawtransaction result object.
+	var fundResult btcjson.FundRawTransactionResult
+	err = json.Unmarshal(res, &fundResult)
+	if err != nil {
+		return nil, err
+	}
+	return &fundResult, nil
+}
+
+// FundRawTransactionAsync returns an instance of a type that can be used to get
+// the result of the RPC at some future time by invoking the Receive function on
+// the returned instance.
+//
+// See FundRawTransaction for the blocking version and more details.
+func (c *Client) FundRawTransactionAsync(tx *wire.MsgTx,
+	options *btcjson.FundRawTransactionOpts) FutureFundRawTransactionResult {
+
+	// Not supported in HTTP POST mode.
+	if c.config.HTTPPostMode {
+		return newFutureError(ErrWebsocketsRequired)
+	}
+
+	txHex := ""
+	if tx != nil {
+		// Serialize the transaction and convert to hex string.
+		buf := bytes.NewBuffer(make([]byte, 0, tx.SerializeSize()))
+		if err := tx.Serialize(buf); err != nil {
+			return newFutureError(err)
+		}
+		txHex = hex.EncodeToString(buf.Bytes())
+	}
+
+	cmd := btcjson.NewFundRawTransactionCmd(txHex, options)
+	return c.sendCmd(cmd)
+}
+
+// FundRawTransaction adds inputs from the wallet, and a change output when
+// needed, to the passed transaction so that it pays for its outputs and fee.
+// The options may be nil to use the wallet defaults.
+//
+// This RPC requires the client to be running in websocket mode.
+func (c *Client) FundRawTransaction(tx *wire.MsgTx,
+	options *btcjson.FundRawTransactionOpts) (*btcjson.FundRawTransactionResult, error) {
+
+	return c.FundRawTransactionAsync(tx, options).Receive()
+}
+
 // FutureSendRawTransactionResult is a future promise to deliver the result
 // of a SendRawTransactionAsync RPC invocation (or an applicable error).
 type FutureSendRawTransactionResult chan *response
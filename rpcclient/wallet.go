@@ -141,6 +141,29 @@ func (c *Client) ListTransactionsCountFrom(account string, count, from int) ([]b
 	return c.ListTransactionsCountFromAsync(account, count, from).Receive()
 }
 
+// ListTransactionsCountFromWatchOnlyAsync returns an instance of a type that
+// can be used to get the result of the RPC at some future time by invoking the
+// Receive function on the returned instance.
+//
+// See ListTransactionsCountFromWatchOnly for the blocking version and more
+// details.
+func (c *Client) ListTransactionsCountFromWatchOnlyAsync(account string, count, from int, watchOnly bool) FutureListTransactionsResult {
+	cmd := btcjson.NewListTransactionsCmd(&account, &count, &from, &watchOnly)
+	return c.sendCmd(cmd)
+}
+
+// ListTransactionsCountFromWatchOnly returns a list of the most recent
+// transactions up to the passed count while skipping the first 'from'
+// transactions.  Transactions involving watch-only addresses are included when
+// watchOnly is true.
+//
+// See the ListTransactionsCountFrom function to exclude watch-only
+// transactions.
+func (c *Client) ListTransactionsCountFromWatchOnly(account string, count, from int, watchOnly bool) ([]btcjson.ListTransactionsResult, error) {
+	return c.ListTransactionsCountFromWatchOnlyAsync(account, count, from,
+		watchOnly).Receive()
+}
+
 // FutureListUnspentResult is a future promise to deliver the result of a
 // ListUnspentAsync, ListUnspentMinAsync, ListUnspentMinMaxAsync, or
 // ListUnspentMinMaxAddressesAsync RPC invocation (or an applicable error).
@@ -316,6 +339,96 @@ func (c *Client) ListSinceBlockMinConf(blockHash *chainhash.Hash, minConfirms in
 // Transaction Send Functions
 // **************************
 
+// FutureAbandonTransactionResult is a future promise to deliver the error
+// result of an AbandonTransactionAsync RPC invocation.
+type FutureAbandonTransactionResult chan *response
+
+// Receive waits for the response promised by the future and returns the result
+// of abandoning the transaction.
+func (r FutureAbandonTransactionResult) Receive() error {
+	_, err := receiveFuture(r)
+	return err
+}
+
+// AbandonTransactionAsync returns an instance of a type that can be used to get
+// the result of the RPC at some future time by invoking the Receive function on
+// the returned instance.
+//
+// See AbandonTransaction for the blocking version and more details.
+func (c *Client) AbandonTransactionAsync(txHash *chainhash.Hash) FutureAbandonTransactionResult {
+	// Not supported in HTTP POST mode.
+	if c.config.HTTPPostMode {
+		return newFutureError(ErrWebsocketsRequired)
+	}
+
+	hash := ""
+	if txHash != nil {
+		hash = txHash.String()
+	}
+
+	cmd := btcjson.NewAbandonTransactionCmd(hash)
+	return c.sendCmd(cmd)
+}
+
+// AbandonTransaction marks the passed unconfirmed wallet transaction, along
+// with its in-wallet descendants, as abandoned so their inputs may be spent
+// again.
+//
+// This RPC requires the client to be running in websocket mode.
+func (c *Client) AbandonTransaction(txHash *chainhash.Hash) error {
+	return c.AbandonTransactionAsync(txHash).Receive()
+}
+
+// FutureBumpFeeResult is a future promise to deliver the result of a
+// BumpFeeAsync RPC invocation (or an applicable error).
+type FutureBumpFeeResult chan *response
+
+// Receive waits for the response promised by the future and returns the
+// details of the replacement transaction.
+func (r FutureBumpFeeResult) Receive() (*btcjson.BumpFeeResult, error) {
+	res, err := receiveFuture(r)
+	if err != nil {
+		return nil, err
+	}
+
+	// Unmarshal result as a bumpfee result object.
+	var bumpResult btcjson.BumpFeeResult
+	err = json.Unmarshal(res, &bumpResult)
+	if err != nil {
+		return nil, err
+	}
+
+	return &bumpResult, nil
+}
+
+// BumpFeeAsync returns an instance of a type that can be used to get the result
+// of the RPC at some future time by invoking the Receive function on the
+// returned instance.
+//
+// See BumpFee for the blocking version and more details.
+func (c *Client) BumpFeeAsync(txHash *chainhash.Hash, options *btcjson.BumpFeeOpts) FutureBumpFeeResult {
+	// Not supported in HTTP POST mode.
+	if c.config.HTTPPostMode {
+		return newFutureError(ErrWebsocketsRequired)
+	}
+
+	hash := ""
+	if txHash != nil {
+		hash = txHash.String()
+	}
+
+	cmd := btcjson.NewBumpFeeCmd(hash, options)
+	return c.sendCmd(cmd)
+}
+
+// BumpFee replaces the passed unconfirmed wallet transaction with one that pays
+// a higher fee.  The transaction must signal replaceability.
+//
+// This RPC requires the client to be running in websocket mode.
+func (c *Client) BumpFee(txHash *chainhash.Hash, options *btcjson.BumpFeeOpts) (*btcjson.BumpFeeResult, error) {
+	return c.BumpFeeAsync(txHash, options).Receive()
+}
+
 // FutureLockUnspentResult is a future promise to deliver the error result of a
 // LockUnspentAsync RPC invocation.
 type FutureLockUnspentResult chan *response
@@ -981,6 +1094,182 @@ func (c *Client) AddWitnessAddress(address string) (btcutil.Address, error) {
 	return c.AddWitnessAddressAsync(address).Receive()
 }
 
+// FutureGetAccountInfoResult is a future promise to deliver the result of a
+// GetAccountInfoAsync RPC invocation (or an applicable error).
+type FutureGetAccountInfoResult chan *response
+
+// Receive waits for the response promised by the future and returns the
+// balances and next receiving address of the account.
+func (r FutureGetAccountInfoResult) Receive() (*btcjson.GetAccountInfoResult, error) {
+	res, err := receiveFuture(r)
+	if err != nil {
+		return nil, err
+	}
+
+	// Unmarshal result as a getaccountinfo result object.
+	var accountInfo btcjson.GetAccountInfoResult
+	err = json.Unmarshal(res, &accountInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	return &accountInfo, nil
+}
+
+// GetAccountInfoAsync returns an instance of a type that can be used to get the
+// result of the RPC at some future time by invoking the Receive function on the
+// returned instance.
+//
+// See GetAccountInfo for the blocking version and more details.
+func (c *Client) GetAccountInfoAsync(account string) FutureGetAccountInfoResult {
+	// Not supported in HTTP POST mode.
+	if c.config.HTTPPostMode {
+		return newFutureError(ErrWebsocketsRequired)
+	}
+
+	cmd := btcjson.NewGetAccountInfoCmd(account)
+	return c.sendCmd(cmd)
+}
+
+// GetAccountInfo returns the name, confirmed and unconfirmed balances, number
+// of addresses and next receiving address of the specified account.
+//
+// This RPC requires the client to be running in websocket mode.
+func (c *Client) GetAccountInfo(account string) (*btcjson.GetAccountInfoResult, error) {
+	return c.GetAccountInfoAsync(account).Receive()
+}
+
+// FutureListScriptsResult is a future promise to deliver the result of a
+// ListScriptsAsync RPC invocation (or an applicable error).
+type FutureListScriptsResult chan *response
+
+// Receive waits for the response promised by the future and returns the redeem
+// scripts that have been imported into the wallet.
+func (r FutureListScriptsResult) Receive() ([]btcjson.ListScriptsResult, error) {
+	res, err := receiveFuture(r)
+	if err != nil {
+		return nil, err
+	}
+
+	// Unmarshal result as an array of listscripts result objects.
+	var scripts []btcjson.ListScriptsResult
+	err = json.Unmarshal(res, &scripts)
+	if err != nil {
+		return nil, err
+	}
+
+	return scripts, nil
+}
+
+// ListScriptsAsync returns an instance of a type that can be used to get the
+// result of the RPC at some future time by invoking the Receive function on the
+// returned instance.
+//
+// See ListScripts for the blocking version and more details.
+func (c *Client) ListScriptsAsync() FutureListScriptsResult {
+	// Not supported in HTTP POST mode.
+	if c.config.HTTPPostMode {
+		return newFutureError(ErrWebsocketsRequired)
+	}
+
+	cmd := btcjson.NewListScriptsCmd()
+	return c.sendCmd(cmd)
+}
+
+// ListScripts returns the hash, address, and script of every redeem script
+// that has been imported into the wallet.
+//
+// This RPC requires the client to be running in websocket mode.
+func (c *Client) ListScripts() ([]btcjson.ListScriptsResult, error) {
+	return c.ListScriptsAsync().Receive()
+}
+
+// FutureSetHDSeedResult is a future promise to deliver the result of a
+// SetHDSeedAsync RPC invocation (or an applicable error).
+type FutureSetHDSeedResult chan *response
+
+// Receive waits for the response promised by the future and returns the result
+// of setting the HD seed.
+func (r FutureSetHDSeedResult) Receive() error {
+	_, err := receiveFuture(r)
+	return err
+}
+
+// SetHDSeedAsync returns an instance of a type that can be used to get the
+// result of the RPC at some future time by invoking the Receive function on the
+// returned instance.
+//
+// See SetHDSeed for the blocking version and more details.
+func (c *Client) SetHDSeedAsync(newKeyPool bool, seed *btcutil.WIF) FutureSetHDSeedResult {
+	// Not supported in HTTP POST mode.
+	if c.config.HTTPPostMode {
+		return newFutureError(ErrWebsocketsRequired)
+	}
+
+	var wif *string
+	if seed != nil {
+		wif = btcjson.String(seed.String())
+	}
+
+	cmd := btcjson.NewSetHDSeedCmd(&newKeyPool, wif)
+	return c.sendCmd(cmd)
+}
+
+// SetHDSeed sets the HD seed of the wallet to the passed private key, or to a
+// newly generated one when it is nil.  When newKeyPool is true, the keypool is
+// flushed and refilled with keys derived from the new seed.
+//
+// This RPC requires the client to be running in websocket mode.
+func (c *Client) SetHDSeed(newKeyPool bool, seed *btcutil.WIF) error {
+	return c.SetHDSeedAsync(newKeyPool, seed).Receive()
+}
+
+// FutureGetHDKeypoolInfoResult is a future promise to deliver the result of a
+// GetHDKeypoolInfoAsync RPC invocation (or an applicable error).
+type FutureGetHDKeypoolInfoResult chan *response
+
+// Receive waits for the response promised by the future and returns the HD
+// seed id and keypool sizes of the wallet.
+func (r FutureGetHDKeypoolInfoResult) Receive() (*btcjson.GetHDKeypoolInfoResult, error) {
+	res, err := receiveFuture(r)
+	if err != nil {
+		return nil, err
+	}
+
+	// Unmarshal result as a gethdkeypoolinfo result object.
+	var keypoolInfo btcjson.GetHDKeypoolInfoResult
+	err = json.Unmarshal(res, &keypoolInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	return &keypoolInfo, nil
+}
+
+// GetHDKeypoolInfoAsync returns an instance of a type that can be used to get
+// the result of the RPC at some future time by invoking the Receive function
+// on the returned instance.
+//
+// See GetHDKeypoolInfo for the blocking version and more details.
+func (c *Client) GetHDKeypoolInfoAsync() FutureGetHDKeypoolInfoResult {
+	// Not supported in HTTP POST mode.
+	if c.config.HTTPPostMode {
+		return newFutureError(ErrWebsocketsRequired)
+	}
+
+	cmd := btcjson.NewGetHDKeypoolInfoCmd()
+	return c.sendCmd(cmd)
+}
+
+// GetHDKeypoolInfo returns the id of the wallet's HD seed, the sizes of its
+// external and internal keypools, and when the wallet will be locked again if
+// it is encrypted.
+//
+// This RPC requires the client to be running in websocket mode.
+func (c *Client) GetHDKeypoolInfo() (*btcjson.GetHDKeypoolInfoResult, error) {
+	return c.GetHDKeypoolInfoAsync().Receive()
+}
+
 // FutureGetAccountAddressResult is a future promise to deliver the result of a
 // GetAccountAddressAsync RPC invocation (or an applicable error).
 type FutureGetAccountAddressResult chan *response
@@ -1507,6 +1796,44 @@ func (c *Client) GetBalanceMinConf(account string, minConfirms int) (btcutil.Amo
 	return c.GetBalanceMinConfAsync(account, minConfirms).Receive()
 }
 
+// FutureGetBalancesResult is a future promise to deliver the result of a
+// GetBalancesAsync RPC invocation (or an applicable error).
+type FutureGetBalancesResult chan *response
+
+// Receive waits for the response promised by the future and returns the
+// spendable, unconfirmed, and immature balances of the wallet.
+func (r FutureGetBalancesResult) Receive() (*btcjson.GetBalancesResult, error) {
+	res, err := receiveFuture(r)
+	if err != nil {
+		return nil, err
+	}
+
+	// Unmarshal result as a getbalances result object.
+	var balances btcjson.GetBalancesResult
+	err = json.Unmarshal(res, &balances)
+	if err != nil {
+		return nil, err
+	}
+
+	return &balances, nil
+}
+
+// GetBalancesAsync returns an instance of a type that can be used to get the
+// result of the RPC at some future time by invoking the Receive function on the
+// returned instance.
+//
+// See GetBalances for the blocking version and more details.
+func (c *Client) GetBalancesAsync() FutureGetBalancesResult {
+	cmd := btcjson.NewGetBalancesCmd()
+	return c.sendCmd(cmd)
+}
+
+// GetBalances returns the available balances from the server broken down into
+// spendable, unconfirmed, and immature amounts.
+func (c *Client) GetBalances() (*btcjson.GetBalancesResult, error) {
+	return c.GetBalancesAsync().Receive()
+}
+
 // FutureGetReceivedByAccountResult is a future promise to deliver the result of
 // a GetReceivedByAccountAsync or GetReceivedByAccountMinConfAsync RPC
 // invocation (or an applicable error).
@@ -2103,6 +2430,51 @@ func (c *Client) ImportAddressRescan(address string, rescan bool) error {
 	return c.ImportAddressRescanAsync(address, rescan).Receive()
 }
 
+// FutureImportMultiResult is a future promise to deliver the result of an
+// ImportMultiAsync RPC invocation (or an applicable error).
+type FutureImportMultiResult chan *response
+
+// Receive waits for the response promised by the future and returns the result
+// of each of the import requests.
+func (r FutureImportMultiResult) Receive() ([]btcjson.ImportMultiResult, error) {
+	res, err := receiveFuture(r)
+	if err != nil {
+		return nil, err
+	}
+
+	// Unmarshal result as an array of importmulti result objects.
+	var importResults []btcjson.ImportMultiResult
+	err = json.Unmarshal(res, &importResults)
+	if err != nil {
+		return nil, err
+	}
+
+	return importResults, nil
+}
+
+// ImportMultiAsync returns an instance of a type that can be used to get the
+// result of the RPC at some future time by invoking the Receive function on the
+// returned instance.
+//
+// See ImportMulti for the blocking version and more details.
+func (c *Client) ImportMultiAsync(requests []btcjson.ImportMultiRequest, options *btcjson.ImportMultiOptions) FutureImportMultiResult {
+	// Not supported in HTTP POST mode.
+	if c.config.HTTPPostMode {
+		return newFutureError(ErrWebsocketsRequired)
+	}
+
+	cmd := btcjson.NewImportMultiCmd(requests, options)
+	return c.sendCmd(cmd)
+}
+
+// ImportMulti imports several scripts, addresses and keys at once.  The results
+// are in the same order as the passed requests.
+//
+// This RPC requires the client to be running in websocket mode.
+func (c *Client) ImportMulti(requests []btcjson.ImportMultiRequest, options *btcjson.ImportMultiOptions) ([]btcjson.ImportMultiResult, error) {
+	return c.ImportMultiAsync(requests, options).Receive()
+}
+
 // FutureImportPrivKeyResult is a future promise to deliver the result of an
 // ImportPrivKeyAsync RPC invocation (or an applicable error).
 type FutureImportPrivKeyResult chan *response
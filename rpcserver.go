@@ -16,6 +16,7 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
+	"math"
 	"math/big"
 	"math/rand"
 	"net"
@@ -152,6 +153,7 @@ var rpcHandlersBeforeInit = map[string]commandHandler{
 	"gethashespersec":       handleGetHashesPerSec,
 	"getheaders":            handleGetHeaders,
 	"getinfo":               handleGetInfo,
+	"getmempoolentry":       handleGetMempoolEntry,
 	"getmempoolinfo":        handleGetMempoolInfo,
 	"getmininginfo":         handleGetMiningInfo,
 	"getnettotals":          handleGetNetTotals,
@@ -190,6 +192,7 @@ var rpcAskWallet = map[string]struct{}{
 	"getaccountaddress":      {},
 	"getaddressesbyaccount":  {},
 	"getbalance":             {},
+	"getbalances":            {},
 	"getnewaddress":          {},
 	"getrawchangeaddress":    {},
 	"getreceivedbyaccount":   {},
@@ -227,7 +230,6 @@ var rpcAskWallet = map[string]struct{}{
 var rpcUnimplemented = map[string]struct{}{
 	"estimatepriority": {},
 	"getchaintips":     {},
-	"getmempoolentry":  {},
 	"getnetworkinfo":   {},
 	"getwork":          {},
 	"invalidateblock":  {},
@@ -238,11 +240,13 @@ var rpcUnimplemented = map[string]struct{}{
 // Commands that are available to a limited user
 var rpcLimited = map[string]struct{}{
 	// Websockets commands
+	"clearnotifications":    {},
 	"loadtxfilter":          {},
 	"notifyblocks":          {},
 	"notifynewtransactions": {},
 	"notifyreceived":        {},
 	"notifyspent":           {},
+	"notifywallet":          {},
 	"rescan":                {},
 	"rescanblocks":          {},
 	"session":               {},
@@ -324,6 +328,17 @@ func rpcNoTxInfoError(txHash *chainhash.Hash) *btcjson.RPCError {
 			txHash))
 }
 
+// toInt32 converts the passed value, such as a block height provided as an RPC
+// parameter, to an int32.  An error is returned when the value does not fit in
+// an int32 rather than silently wrapping around.
+func toInt32(v int64) (int32, error) {
+	if v < math.MinInt32 || v > math.MaxInt32 {
+		return 0, fmt.Errorf("%d is out of range [%d, %d]", v,
+			math.MinInt32, math.MaxInt32)
+	}
+	return int32(v), nil
+}
+
 // gbtWorkState houses state that is used in between multiple RPC invocations to
 // getblocktemplate.
 type gbtWorkState struct {
@@ -868,6 +883,11 @@ func handleEstimateFee(s *rpcServer, cmd interface{}, closeChan <-chan struct{})
 
 	feeRate, err := s.cfg.FeeEstimator.EstimateFee(uint32(c.NumBlocks))
 
+	// Mirror bitcoind by reporting -1 rather than an error when the
+	// estimator has not observed enough blocks to produce an estimate.
+	if err == mempool.ErrNotEnoughBlocks {
+		return -1.0, nil
+	}
 	if err != nil {
 		return -1.0, err
 	}
@@ -1063,11 +1083,31 @@ func getDifficultyRatio(bits uint32, params *chaincfg.Params) float64 {
 func handleGetBlock(s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (interface{}, error) {
 	c := cmd.(*btcjson.GetBlockCmd)
 
-	// Load the raw block bytes from the database.
-	hash, err := chainhash.NewHashFromStr(c.Hash)
-	if err != nil {
-		return nil, rpcDecodeHexError(c.Hash)
+	// Resolve the block hash.  A decimal number which is shorter than a
+	// block hash identifies the block by its height in the main chain.
+	var hash *chainhash.Hash
+	var err error
+	height, heightErr := strconv.ParseInt(c.Hash, 10, 64)
+	if heightErr == nil && len(c.Hash) < chainhash.MaxHashStringSize {
+		var height32 int32
+		height32, err = toInt32(height)
+		if err == nil {
+			hash, err = s.cfg.Chain.BlockHashByHeight(height32)
+		}
+		if err != nil {
+			return nil, &btcjson.RPCError{
+				Code:    btcjson.ErrRPCOutOfRange,
+				Message: "Block number out of range",
+			}
+		}
+	} else {
+		hash, err = chainhash.NewHashFromStr(c.Hash)
+		if err != nil {
+			return nil, rpcDecodeHexError(c.Hash)
+		}
 	}
+
+	// Load the raw block bytes from the database.
 	var blkBytes []byte
 	err = s.cfg.DB.View(func(dbTx database.Tx) error {
 		var err error
@@ -1119,7 +1159,7 @@ func handleGetBlock(s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (i
 	params := s.cfg.ChainParams
 	blockHeader := &blk.MsgBlock().Header
 	blockReply := btcjson.GetBlockVerboseResult{
-		Hash:          c.Hash,
+		Hash:          hash.String(),
 		Version:       blockHeader.Version,
 		VersionHex:    fmt.Sprintf("%08x", blockHeader.Version),
 		MerkleRoot:    blockHeader.MerkleRoot.String(),
@@ -1300,7 +1340,14 @@ func handleGetBlockCount(s *rpcServer, cmd interface{}, closeChan <-chan struct{
 // handleGetBlockHash implements the getblockhash command.
 func handleGetBlockHash(s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (interface{}, error) {
 	c := cmd.(*btcjson.GetBlockHashCmd)
-	hash, err := s.cfg.Chain.BlockHashByHeight(int32(c.Index))
+	height, err := toInt32(c.Index)
+	if err != nil {
+		return nil, &btcjson.RPCError{
+			Code:    btcjson.ErrRPCOutOfRange,
+			Message: "Block number out of range",
+		}
+	}
+	hash, err := s.cfg.Chain.BlockHashByHeight(height)
 	if err != nil {
 		return nil, &btcjson.RPCError{
 			Code:    btcjson.ErrRPCOutOfRange,
@@ -2317,6 +2364,44 @@ func handleGetInfo(s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (in
 	return ret, nil
 }
 
+// handleGetMempoolEntry implements the getmempoolentry command.
+func handleGetMempoolEntry(s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (interface{}, error) {
+	c := cmd.(*btcjson.GetMempoolEntryCmd)
+
+	txHash, err := chainhash.NewHashFromStr(c.TxID)
+	if err != nil {
+		return nil, rpcDecodeHexError(c.TxID)
+	}
+
+	mp := s.cfg.TxMemPool
+	entry, err := mp.RawMempoolEntryVerbose(txHash)
+	if err != nil {
+		return nil, rpcNoTxInfoError(txHash)
+	}
+	ancestors, descendants, err := mp.PackageStats(txHash)
+	if err != nil {
+		// The transaction was removed from the pool in between.
+		return nil, rpcNoTxInfoError(txHash)
+	}
+
+	return &btcjson.GetMempoolEntryResult{
+		Size:             entry.Size,
+		Fee:              entry.Fee,
+		ModifiedFee:      entry.Fee,
+		Time:             entry.Time,
+		Height:           entry.Height,
+		StartingPriority: entry.StartingPriority,
+		CurrentPriority:  entry.CurrentPriority,
+		DescendantCount:  descendants.Count,
+		DescendantSize:   descendants.Size,
+		DescendantFees:   btcutil.Amount(descendants.Fees).ToBTC(),
+		AncestorCount:    ancestors.Count,
+		AncestorSize:     ancestors.Size,
+		AncestorFees:     btcutil.Amount(ancestors.Fees).ToBTC(),
+		Depends:          entry.Depends,
+	}, nil
+}
+
 // handleGetMempoolInfo implements the getmempoolinfo command.
 func handleGetMempoolInfo(s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (interface{}, error) {
 	mempoolTxns := s.cfg.TxMemPool.TxDescs()
@@ -2327,8 +2412,9 @@ func handleGetMempoolInfo(s *rpcServer, cmd interface{}, closeChan <-chan struct
 	}
 
 	ret := &btcjson.GetMempoolInfoResult{
-		Size:  int64(len(mempoolTxns)),
-		Bytes: numBytes,
+		Size:          int64(len(mempoolTxns)),
+		Bytes:         numBytes,
+		MempoolMinFee: cfg.minRelayTxFee.ToBTC(),
 	}
 
 	return ret, nil
@@ -2396,7 +2482,12 @@ func handleGetNetworkHashPS(s *rpcServer, cmd interface{}, closeChan <-chan stru
 	best := s.cfg.Chain.BestSnapshot()
 	endHeight := int32(-1)
 	if c.Height != nil {
-		endHeight = int32(*c.Height)
+		height, err := toInt32(int64(*c.Height))
+		if err != nil {
+			return nil, btcjson.NewRPCError(btcjson.ErrRPCInvalidParameter,
+				fmt.Sprintf("Invalid height: %v", err))
+		}
+		endHeight = height
 	}
 	if endHeight > best.Height || endHeight == 0 {
 		return int64(0), nil
@@ -2416,7 +2507,12 @@ func handleGetNetworkHashPS(s *rpcServer, cmd interface{}, closeChan <-chan stru
 	// starting height is not before the beginning of the chain.
 	numBlocks := int32(120)
 	if c.Blocks != nil {
-		numBlocks = int32(*c.Blocks)
+		blocks, err := toInt32(int64(*c.Blocks))
+		if err != nil {
+			return nil, btcjson.NewRPCError(btcjson.ErrRPCInvalidParameter,
+				fmt.Sprintf("Invalid number of blocks: %v", err))
+		}
+		numBlocks = blocks
 	}
 	var startHeight int32
 	if numBlocks <= 0 {
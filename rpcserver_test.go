@@ -0,0 +1,52 @@
+// Copyright (c) 2017 The btcsuite developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"math"
+	"testing"
+)
+
+// TestToInt32 ensures toInt32 converts values at the int32 boundaries and
+// rejects values which are out of range.
+func TestToInt32(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		in      int64
+		want    int32
+		wantErr bool
+	}{
+		{name: "zero", in: 0, want: 0},
+		{name: "negative one", in: -1, want: -1},
+		{name: "max int32", in: math.MaxInt32, want: math.MaxInt32},
+		{name: "min int32", in: math.MinInt32, want: math.MinInt32},
+		{name: "max int32 + 1", in: math.MaxInt32 + 1, wantErr: true},
+		{name: "min int32 - 1", in: math.MinInt32 - 1, wantErr: true},
+		{name: "max int64", in: math.MaxInt64, wantErr: true},
+		{name: "min int64", in: math.MinInt64, wantErr: true},
+	}
+
+	for i, test := range tests {
+		got, err := toInt32(test.in)
+		if test.wantErr {
+			if err == nil {
+				t.Errorf("Test #%d (%s) unexpected success - "+
+					"got %d", i, test.name, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Test #%d (%s) unexpected error: %v", i,
+				test.name, err)
+			continue
+		}
+		if got != test.want {
+			t.Errorf("Test #%d (%s) got %d, want %d", i,
+				test.name, got, test.want)
+		}
+	}
+}
@@ -122,7 +122,8 @@ var helpDescsEnUS = map[string]string{
 	"estimatefee-numblocks": "The maximum number of blocks which can be " +
 		"generated before the transaction is mined.",
 	"estimatefee--result0": "Estimated fee per kilobyte in satoshis for a block to " +
-		"be mined in the next NumBlocks blocks.",
+		"be mined in the next NumBlocks blocks, or -1 if not enough blocks " +
+		"have been observed to make an estimate.",
 
 	// GenerateCmd help
 	"generate--synopsis": "Generates a set number of blocks (simnet or regtest only) and returns a JSON\n" +
@@ -160,8 +161,8 @@ var helpDescsEnUS = map[string]string{
 	"getbestblockhash--result0":  "The hex-encoded block hash",
 
 	// GetBlockCmd help.
-	"getblock--synopsis":   "Returns information about a block given its hash.",
-	"getblock-hash":        "The hash of the block",
+	"getblock--synopsis":   "Returns information about a block given its hash or height.",
+	"getblock-hash":        "The hash of the block, or its height in the main chain as a decimal string",
 	"getblock-verbose":     "Specifies the block is returned as a JSON object instead of hex-encoded string",
 	"getblock-verbosetx":   "Specifies that each transaction is returned as a JSON object and only applies if the verbose flag is true (btcd extension)",
 	"getblock--condition0": "verbose=false",
@@ -277,6 +278,7 @@ var helpDescsEnUS = map[string]string{
 	// TemplateRequest help.
 	"templaterequest-mode":         "This is 'template', 'proposal', or omitted",
 	"templaterequest-capabilities": "List of capabilities",
+	"templaterequest-rules":        "List of softfork deployment rules supported by the client (this parameter is ignored)",
 	"templaterequest-longpollid":   "The long poll ID of a job to monitor for expiration; required and valid only for long poll requests ",
 	"templaterequest-sigoplimit":   "Number of signature operations allowed in blocks (this parameter is ignored)",
 	"templaterequest-sizelimit":    "Number of bytes allowed in blocks (this parameter is ignored)",
@@ -403,12 +405,35 @@ var helpDescsEnUS = map[string]string{
 	// GetInfoCmd help.
 	"getinfo--synopsis": "Returns a JSON object containing various state info.",
 
+	// GetMempoolEntryCmd help.
+	"getmempoolentry--synopsis": "Returns mempool data for the given transaction.",
+	"getmempoolentry-txid":      "The hash of the transaction",
+
+	// GetMempoolEntryResult help.
+	"getmempoolentryresult-size":             "Transaction size in bytes",
+	"getmempoolentryresult-fee":              "Transaction fee in bitcoins",
+	"getmempoolentryresult-modifiedfee":      "Transaction fee with fee deltas used for mining priority",
+	"getmempoolentryresult-time":             "Local time transaction entered pool in seconds since 1 Jan 1970 GMT",
+	"getmempoolentryresult-height":           "Block height when transaction entered the pool",
+	"getmempoolentryresult-startingpriority": "Priority when transaction entered the pool",
+	"getmempoolentryresult-currentpriority":  "Current priority",
+	"getmempoolentryresult-descendantcount":  "Number of in-mempool descendant transactions (including this one)",
+	"getmempoolentryresult-descendantsize":   "Size of in-mempool descendants (including this one)",
+	"getmempoolentryresult-descendantfees":   "Fees of in-mempool descendants (including this one) in bitcoins",
+	"getmempoolentryresult-ancestorcount":    "Number of in-mempool ancestor transactions (including this one)",
+	"getmempoolentryresult-ancestorsize":     "Size of in-mempool ancestors (including this one)",
+	"getmempoolentryresult-ancestorfees":     "Fees of in-mempool ancestors (including this one) in bitcoins",
+	"getmempoolentryresult-depends":          "Unconfirmed transactions used as inputs for this transaction",
+
 	// GetMempoolInfoCmd help.
 	"getmempoolinfo--synopsis": "Returns memory pool information",
 
 	// GetMempoolInfoResult help.
-	"getmempoolinforesult-bytes": "Size in bytes of the mempool",
-	"getmempoolinforesult-size":  "Number of transactions in the mempool",
+	"getmempoolinforesult-bytes":         "Size in bytes of the mempool",
+	"getmempoolinforesult-size":          "Number of transactions in the mempool",
+	"getmempoolinforesult-usage":         "Total memory usage for the mempool (not reported by btcd)",
+	"getmempoolinforesult-maxmempool":    "Maximum memory usage for the mempool (not reported by btcd since the mempool is not size limited)",
+	"getmempoolinforesult-mempoolminfee": "Minimum fee rate in BTC/kB for a transaction to be accepted",
 
 	// GetMiningInfoResult help.
 	"getmininginforesult-blocks":             "Height of the latest best block",
@@ -575,9 +600,10 @@ var helpDescsEnUS = map[string]string{
 		"For btcd this is:\n" +
 		"checklevel=0 - Look up each block and ensure it can be loaded from the database.\n" +
 		"checklevel=1 - Perform basic context-free sanity checks on each block.",
-	"verifychain-checklevel": "How thorough the block verification is",
-	"verifychain-checkdepth": "The number of blocks to check",
-	"verifychain--result0":   "Whether or not the chain verified",
+	"verifychain-checklevel":    "How thorough the block verification is",
+	"verifychain-checkdepth":    "The number of blocks to check",
+	"verifychain-timeoutmillis": "Hint of how long, in milliseconds, the client will wait for the verification to complete",
+	"verifychain--result0":      "Whether or not the chain verified",
 
 	// VerifyMessageCmd help.
 	"verifymessage--synopsis": "Verify a signed message.",
@@ -592,6 +618,9 @@ var helpDescsEnUS = map[string]string{
 	"session--synopsis":       "Return details regarding a websocket client's current connection session.",
 	"sessionresult-sessionid": "The unique session ID for a client's websocket connection.",
 
+	// ClearNotificationsCmd help.
+	"clearnotifications--synopsis": "Cancel all registered notifications and clear any loaded transaction filter for the websocket client.",
+
 	// NotifyBlocksCmd help.
 	"notifyblocks--synopsis": "Request notifications for whenever a block is connected or disconnected from the main (best) chain.",
 
@@ -619,8 +648,14 @@ var helpDescsEnUS = map[string]string{
 	"outpoint-index": "The index of the outpoint",
 
 	// NotifySpentCmd help.
-	"notifyspent--synopsis": "Send a redeemingtx notification when a transaction spending an outpoint appears in mempool (if relayed to this btcd instance) and when such a transaction first appears in a newly-attached block.",
-	"notifyspent-outpoints": "List of transaction outpoints to monitor.",
+	"notifyspent--synopsis":    "Send a redeemingtx notification when a transaction spending an outpoint appears in mempool (if relayed to this btcd instance) and when such a transaction first appears in a newly-attached block.",
+	"notifyspent-outpoints":    "List of transaction outpoints to monitor.",
+	"notifyspent-checkhistory": "Whether to send an immediate redeemingtx notification for outpoints which are already spent by a transaction in the memory pool",
+
+	// NotifyWalletCmd help.
+	"notifywallet--synopsis": "Register for both recvtx and redeemingtx notifications in a single request, as if notifyreceived and notifyspent were issued together.",
+	"notifywallet-addresses": "List of address to receive notifications about",
+	"notifywallet-outpoints": "List of transaction outpoints to monitor.",
 
 	// StopNotifySpentCmd help.
 	"stopnotifyspent--synopsis": "Cancel registered spending notifications for each passed outpoint.",
@@ -637,10 +672,11 @@ var helpDescsEnUS = map[string]string{
 		"When the endblock parameter is omitted, the rescan continues through the best block in the main chain.\n" +
 		"Rescan results are sent as recvtx and redeemingtx notifications.\n" +
 		"This call returns once the rescan completes.",
-	"rescan-beginblock": "Hash of the first block to begin rescanning",
-	"rescan-addresses":  "List of addresses to include in the rescan",
-	"rescan-outpoints":  "List of transaction outpoints to include in the rescan",
-	"rescan-endblock":   "Hash of final block to rescan",
+	"rescan-beginblock":    "Hash of the first block to begin rescanning",
+	"rescan-addresses":     "List of addresses to include in the rescan",
+	"rescan-outpoints":     "List of transaction outpoints to include in the rescan",
+	"rescan-endblock":      "Hash of final block to rescan",
+	"rescan-timeoutmillis": "Hint of how long, in milliseconds, the client will wait for the rescan to complete",
 
 	// RescanBlocks help.
 	"rescanblocks--synopsis":   "Rescan blocks for transactions matching the loaded transaction filter.",
@@ -699,6 +735,7 @@ var rpcResultTypes = map[string][]interface{}{
 	"gethashespersec":       {(*float64)(nil)},
 	"getheaders":            {(*[]string)(nil)},
 	"getinfo":               {(*btcjson.InfoChainResult)(nil)},
+	"getmempoolentry":       {(*btcjson.GetMempoolEntryResult)(nil)},
 	"getmempoolinfo":        {(*btcjson.GetMempoolInfoResult)(nil)},
 	"getmininginfo":         {(*btcjson.GetMiningInfoResult)(nil)},
 	"getnettotals":          {(*btcjson.GetNetTotalsResult)(nil)},
@@ -722,6 +759,7 @@ var rpcResultTypes = map[string][]interface{}{
 	"version":               {(*map[string]btcjson.VersionResult)(nil)},
 
 	// Websocket commands.
+	"clearnotifications":        nil,
 	"loadtxfilter":              nil,
 	"session":                   {(*btcjson.SessionResult)(nil)},
 	"notifyblocks":              nil,
@@ -732,6 +770,7 @@ var rpcResultTypes = map[string][]interface{}{
 	"stopnotifyreceived":        nil,
 	"notifyspent":               nil,
 	"stopnotifyspent":           nil,
+	"notifywallet":              nil,
 	"rescan":                    nil,
 	"rescanblocks":              {(*[]btcjson.RescannedBlock)(nil)},
 }
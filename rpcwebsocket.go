@@ -64,12 +64,14 @@ type wsCommandHandler func(*wsClient, interface{}) (interface{}, error)
 // causes a dependency loop.
 var wsHandlers map[string]wsCommandHandler
 var wsHandlersBeforeInit = map[string]wsCommandHandler{
+	"clearnotifications":        handleClearNotifications,
 	"loadtxfilter":              handleLoadTxFilter,
 	"help":                      handleWebsocketHelp,
 	"notifyblocks":              handleNotifyBlocks,
 	"notifynewtransactions":     handleNotifyNewTransactions,
 	"notifyreceived":            handleNotifyReceived,
 	"notifyspent":               handleNotifySpent,
+	"notifywallet":              handleNotifyWallet,
 	"session":                   handleSession,
 	"stopnotifyblocks":          handleStopNotifyBlocks,
 	"stopnotifynewtransactions": handleStopNotifyNewTransactions,
@@ -456,6 +458,7 @@ type notificationTxAcceptedByMempool struct {
 // Notification control requests
 type notificationRegisterClient wsClient
 type notificationUnregisterClient wsClient
+type notificationUnregisterAll wsClient
 type notificationRegisterBlocks wsClient
 type notificationUnregisterBlocks wsClient
 type notificationRegisterNewMempoolTxs wsClient
@@ -472,6 +475,11 @@ type notificationRegisterAddr struct {
 	wsc   *wsClient
 	addrs []string
 }
+type notificationRegisterWallet struct {
+	wsc   *wsClient
+	addrs []string
+	ops   []*wire.OutPoint
+}
 type notificationUnregisterAddr struct {
 	wsc  *wsClient
 	addr string
@@ -556,17 +564,17 @@ out:
 				wsc := (*wsClient)(n)
 				// Remove any requests made by the client as well as
 				// the client itself.
-				delete(blockNotifications, wsc.quit)
-				delete(txNotifications, wsc.quit)
-				for k := range wsc.spentRequests {
-					op := k
-					m.removeSpentRequest(watchedOutPoints, wsc, &op)
-				}
-				for addr := range wsc.addrRequests {
-					m.removeAddrRequest(watchedAddrs, wsc, addr)
-				}
+				m.removeAllRequests(blockNotifications,
+					txNotifications, watchedOutPoints,
+					watchedAddrs, wsc)
 				delete(clients, wsc.quit)
 
+			case *notificationUnregisterAll:
+				wsc := (*wsClient)(n)
+				m.removeAllRequests(blockNotifications,
+					txNotifications, watchedOutPoints,
+					watchedAddrs, wsc)
+
 			case *notificationRegisterSpent:
 				m.addSpentRequests(watchedOutPoints, n.wsc, n.ops)
 
@@ -576,6 +584,10 @@ out:
 			case *notificationRegisterAddr:
 				m.addAddrRequests(watchedAddrs, n.wsc, n.addrs)
 
+			case *notificationRegisterWallet:
+				m.addAddrRequests(watchedAddrs, n.wsc, n.addrs)
+				m.addSpentRequests(watchedOutPoints, n.wsc, n.ops)
+
 			case *notificationUnregisterAddr:
 				m.removeAddrRequest(watchedAddrs, n.wsc, n.addr)
 
@@ -605,6 +617,24 @@ out:
 	m.wg.Done()
 }
 
+// removeAllRequests removes every block, transaction, spent outpoint, and
+// address notification request made by the websocket client wsc.
+func (m *wsNotificationManager) removeAllRequests(
+	blockNotifications, txNotifications map[chan struct{}]*wsClient,
+	watchedOutPoints map[wire.OutPoint]map[chan struct{}]*wsClient,
+	watchedAddrs map[string]map[chan struct{}]*wsClient, wsc *wsClient) {
+
+	delete(blockNotifications, wsc.quit)
+	delete(txNotifications, wsc.quit)
+	for k := range wsc.spentRequests {
+		op := k
+		m.removeSpentRequest(watchedOutPoints, wsc, &op)
+	}
+	for addr := range wsc.addrRequests {
+		m.removeAddrRequest(watchedAddrs, wsc, addr)
+	}
+}
+
 // NumClients returns the number of clients actively being served.
 func (m *wsNotificationManager) NumClients() (n int) {
 	select {
@@ -1121,6 +1151,21 @@ func (m *wsNotificationManager) RegisterTxOutAddressRequests(wsc *wsClient, addr
 	}
 }
 
+// RegisterWalletRequests requests notifications to the passed websocket client
+// when a transaction output spends to any of the passed addresses or any of the
+// passed outpoints is spent.  Both sets of requests are added by a single
+// queued operation, so no transaction can be processed after one set has been
+// registered but before the other.
+func (m *wsNotificationManager) RegisterWalletRequests(wsc *wsClient,
+	addrs []string, ops []*wire.OutPoint) {
+
+	m.queueNotification <- &notificationRegisterWallet{
+		wsc:   wsc,
+		addrs: addrs,
+		ops:   ops,
+	}
+}
+
 // addAddrRequests adds the websocket client wsc to the address to client set
 // addrMap so wsc will be notified for any mempool or block transaction outputs
 // spending to any of the addresses in addrs.
@@ -1182,6 +1227,12 @@ func (m *wsNotificationManager) AddClient(wsc *wsClient) {
 	m.queueNotification <- (*notificationRegisterClient)(wsc)
 }
 
+// UnregisterAllRequests removes all notifications registered for the passed
+// websocket client without removing the client itself.
+func (m *wsNotificationManager) UnregisterAllRequests(wsc *wsClient) {
+	m.queueNotification <- (*notificationUnregisterAll)(wsc)
+}
+
 // RemoveClient removes the passed websocket client and all notifications
 // registered for it.
 func (m *wsNotificationManager) RemoveClient(wsc *wsClient) {
@@ -1781,6 +1832,19 @@ func handleWebsocketHelp(wsc *wsClient, icmd interface{}) (interface{}, error) {
 	return help, nil
 }
 
+// handleClearNotifications implements the clearnotifications command extension
+// for websocket connections.  It removes every notification registered by the
+// client along with any loaded transaction filter.
+func handleClearNotifications(wsc *wsClient, icmd interface{}) (interface{}, error) {
+	wsc.server.ntfnMgr.UnregisterAllRequests(wsc)
+
+	wsc.Lock()
+	wsc.filterData = nil
+	wsc.Unlock()
+
+	return nil, nil
+}
+
 // handleLoadTxFilter implements the loadtxfilter command extension for
 // websocket connections.
 //
@@ -1860,9 +1924,39 @@ func handleNotifySpent(wsc *wsClient, icmd interface{}) (interface{}, error) {
 	}
 
 	wsc.server.ntfnMgr.RegisterSpentRequests(wsc, outpoints)
+	if cmd.CheckHistory == nil || *cmd.CheckHistory {
+		notifyMempoolSpends(wsc, outpoints)
+	}
 	return nil, nil
 }
 
+// notifyMempoolSpends sends the passed websocket client a redeemingtx
+// notification for each transaction in the memory pool which spends one of the
+// passed outpoints.  Spends in blocks are not reported since there is no index
+// to look up the transaction which spent an output.
+func notifyMempoolSpends(wsc *wsClient, outpoints []*wire.OutPoint) {
+	notified := make(map[chainhash.Hash]struct{})
+	for _, op := range outpoints {
+		tx := wsc.server.cfg.TxMemPool.CheckSpend(*op)
+		if tx == nil {
+			continue
+		}
+		if _, ok := notified[*tx.Hash()]; ok {
+			continue
+		}
+		notified[*tx.Hash()] = struct{}{}
+
+		marshalledJSON, err := newRedeemingTxNotification(
+			txHexString(tx.MsgTx()), tx.Index(), nil)
+		if err != nil {
+			rpcsLog.Warnf("Failed to marshal redeemingtx "+
+				"notification: %v", err)
+			continue
+		}
+		wsc.QueueNotification(marshalledJSON)
+	}
+}
+
 // handleNotifyNewTransations implements the notifynewtransactions command
 // extension for websocket connections.
 func handleNotifyNewTransactions(wsc *wsClient, icmd interface{}) (interface{}, error) {
@@ -1902,6 +1996,30 @@ func handleNotifyReceived(wsc *wsClient, icmd interface{}) (interface{}, error)
 	return nil, nil
 }
 
+// handleNotifyWallet implements the notifywallet command extension for
+// websocket connections.  Both the addresses and outpoints are validated
+// before either set of requests is registered so a malformed request does not
+// leave the client partially subscribed, and both sets are then registered
+// together so no notification can be observed for only one of them.
+func handleNotifyWallet(wsc *wsClient, icmd interface{}) (interface{}, error) {
+	cmd, ok := icmd.(*btcjson.NotifyWalletCmd)
+	if !ok {
+		return nil, btcjson.ErrRPCInternal
+	}
+
+	err := checkAddressValidity(cmd.Addresses, wsc.server.cfg.ChainParams)
+	if err != nil {
+		return nil, err
+	}
+	outpoints, err := deserializeOutpoints(cmd.OutPoints)
+	if err != nil {
+		return nil, err
+	}
+
+	wsc.server.ntfnMgr.RegisterWalletRequests(wsc, cmd.Addresses, outpoints)
+	return nil, nil
+}
+
 // handleStopNotifySpent implements the stopnotifyspent command extension for
 // websocket connections.
 func handleStopNotifySpent(wsc *wsClient, icmd interface{}) (interface{}, error) {
@@ -2317,6 +2435,54 @@ func descendantBlock(prevHash *chainhash.Hash, curBlock *btcutil.Block) error {
 	return nil
 }
 
+// rescanBlockRange returns the heights of the passed begin and end blocks of a
+// rescan as resolved by heightByHash.  When no end block is provided, the end
+// height is math.MaxInt32 to indicate the rescan continues through the best
+// chain tip.  An error is returned when an explicit end block is before the
+// begin block since the reversed range would silently scan nothing.
+func rescanBlockRange(heightByHash func(*chainhash.Hash) (int32, error),
+	beginBlock string, endBlock *string) (int32, int32, error) {
+
+	minBlockHash, err := chainhash.NewHashFromStr(beginBlock)
+	if err != nil {
+		return 0, 0, rpcDecodeHexError(beginBlock)
+	}
+	minBlock, err := heightByHash(minBlockHash)
+	if err != nil {
+		return 0, 0, &btcjson.RPCError{
+			Code:    btcjson.ErrRPCBlockNotFound,
+			Message: "Error getting block: " + err.Error(),
+		}
+	}
+
+	maxBlock := int32(math.MaxInt32)
+	if endBlock != nil {
+		maxBlockHash, err := chainhash.NewHashFromStr(*endBlock)
+		if err != nil {
+			return 0, 0, rpcDecodeHexError(*endBlock)
+		}
+		maxBlock, err = heightByHash(maxBlockHash)
+		if err != nil {
+			return 0, 0, &btcjson.RPCError{
+				Code:    btcjson.ErrRPCBlockNotFound,
+				Message: "Error getting block: " + err.Error(),
+			}
+		}
+
+		if maxBlock < minBlock {
+			return 0, 0, &btcjson.RPCError{
+				Code: btcjson.ErrRPCInvalidParameter,
+				Message: fmt.Sprintf("End block %s (height %d) "+
+					"is before begin block %s (height %d)",
+					*endBlock, maxBlock, beginBlock,
+					minBlock),
+			}
+		}
+	}
+
+	return minBlock, maxBlock, nil
+}
+
 // handleRescan implements the rescan command extension for websocket
 // connections.
 //
@@ -2412,31 +2578,10 @@ func handleRescan(wsc *wsClient, icmd interface{}) (interface{}, error) {
 
 	chain := wsc.server.cfg.Chain
 
-	minBlockHash, err := chainhash.NewHashFromStr(cmd.BeginBlock)
-	if err != nil {
-		return nil, rpcDecodeHexError(cmd.BeginBlock)
-	}
-	minBlock, err := chain.BlockHeightByHash(minBlockHash)
+	minBlock, maxBlock, err := rescanBlockRange(chain.BlockHeightByHash,
+		cmd.BeginBlock, cmd.EndBlock)
 	if err != nil {
-		return nil, &btcjson.RPCError{
-			Code:    btcjson.ErrRPCBlockNotFound,
-			Message: "Error getting block: " + err.Error(),
-		}
-	}
-
-	maxBlock := int32(math.MaxInt32)
-	if cmd.EndBlock != nil {
-		maxBlockHash, err := chainhash.NewHashFromStr(*cmd.EndBlock)
-		if err != nil {
-			return nil, rpcDecodeHexError(*cmd.EndBlock)
-		}
-		maxBlock, err = chain.BlockHeightByHash(maxBlockHash)
-		if err != nil {
-			return nil, &btcjson.RPCError{
-				Code:    btcjson.ErrRPCBlockNotFound,
-				Message: "Error getting block: " + err.Error(),
-			}
-		}
+		return nil, err
 	}
 
 	// lastBlock and lastBlockHash track the previously-rescanned block.
@@ -0,0 +1,127 @@
+// Copyright (c) 2017 The btcsuite developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcjson"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+)
+
+// TestRescanBlockRange ensures the begin and end blocks of a rescan resolve to
+// the expected heights, that a reversed range is rejected, and that omitting
+// the end block scans through the best chain tip.
+func TestRescanBlockRange(t *testing.T) {
+	t.Parallel()
+
+	const (
+		block10 = "000000000000000000000000000000000000000000000000000000000000000a"
+		block20 = "0000000000000000000000000000000000000000000000000000000000000014"
+		unknown = "00000000000000000000000000000000000000000000000000000000000000ff"
+	)
+	heights := make(map[chainhash.Hash]int32)
+	for str, height := range map[string]int32{block10: 10, block20: 20} {
+		hash, err := chainhash.NewHashFromStr(str)
+		if err != nil {
+			t.Fatalf("NewHashFromStr: unexpected error: %v", err)
+		}
+		heights[*hash] = height
+	}
+	heightByHash := func(hash *chainhash.Hash) (int32, error) {
+		height, ok := heights[*hash]
+		if !ok {
+			return 0, errors.New("block not found")
+		}
+		return height, nil
+	}
+
+	strPtr := func(s string) *string { return &s }
+	tests := []struct {
+		name     string
+		begin    string
+		end      *string
+		minBlock int32
+		maxBlock int32
+		code     btcjson.RPCErrorCode
+	}{
+		{
+			name:     "ascending range",
+			begin:    block10,
+			end:      strPtr(block20),
+			minBlock: 10,
+			maxBlock: 20,
+		},
+		{
+			name:     "equal range",
+			begin:    block20,
+			end:      strPtr(block20),
+			minBlock: 20,
+			maxBlock: 20,
+		},
+		{
+			name:     "no end block scans through the tip",
+			begin:    block20,
+			minBlock: 20,
+			maxBlock: math.MaxInt32,
+		},
+		{
+			name:  "reversed range",
+			begin: block20,
+			end:   strPtr(block10),
+			code:  btcjson.ErrRPCInvalidParameter,
+		},
+		{
+			name:  "unknown begin block",
+			begin: unknown,
+			end:   strPtr(block20),
+			code:  btcjson.ErrRPCBlockNotFound,
+		},
+		{
+			name:  "unknown end block",
+			begin: block10,
+			end:   strPtr(unknown),
+			code:  btcjson.ErrRPCBlockNotFound,
+		},
+		{
+			name:  "malformed end block",
+			begin: block10,
+			end:   strPtr("xyz"),
+			code:  btcjson.ErrRPCDecodeHexString,
+		},
+	}
+
+	for i, test := range tests {
+		minBlock, maxBlock, err := rescanBlockRange(heightByHash,
+			test.begin, test.end)
+		if test.code != 0 {
+			rpcErr, ok := err.(*btcjson.RPCError)
+			if !ok {
+				t.Errorf("Test #%d (%s) wrong error - got %T (%v), "+
+					"want *btcjson.RPCError", i, test.name,
+					err, err)
+				continue
+			}
+			if rpcErr.Code != test.code {
+				t.Errorf("Test #%d (%s) mismatched error code - "+
+					"got %d, want %d", i, test.name,
+					rpcErr.Code, test.code)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Test #%d (%s) unexpected error: %v", i,
+				test.name, err)
+			continue
+		}
+		if minBlock != test.minBlock || maxBlock != test.maxBlock {
+			t.Errorf("Test #%d (%s) got range [%d, %d], want "+
+				"[%d, %d]", i, test.name, minBlock, maxBlock,
+				test.minBlock, test.maxBlock)
+		}
+	}
+}